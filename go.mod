@@ -13,3 +13,5 @@ require (
 )
 
 require golang.org/x/exp v0.0.0-20240531132922-fd00a4e0eefc
+
+require golang.org/x/text v0.16.0
@@ -0,0 +1,104 @@
+package comparator
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+type CaseFolding int
+
+const (
+	SimpleCaseFolding CaseFolding = iota
+	FullCaseFolding
+)
+
+func CaseFoldOrder(folding CaseFolding) Comparator[string] {
+	return func(a string, b string) int {
+		foldedA := foldedRunes{remaining: a, full: folding == FullCaseFolding}
+		foldedB := foldedRunes{remaining: b, full: folding == FullCaseFolding}
+
+		for {
+			runeA, hasA := foldedA.next()
+			runeB, hasB := foldedB.next()
+
+			switch {
+			case !hasA || !hasB:
+				return boolToInt(hasA) - boolToInt(hasB)
+			case runeA != runeB:
+				return sign(int(runeA - runeB))
+			}
+		}
+	}
+}
+
+func CaseFoldOrderBy[T any](folding CaseFolding, selector Selector[T, string]) Comparator[T] {
+	return BySelector[T, string](CaseFoldOrder(folding), selector)
+}
+
+type foldedRunes struct {
+	remaining string
+	expansion []rune
+	full      bool
+}
+
+func (f *foldedRunes) next() (rune, bool) {
+	if len(f.expansion) > 0 {
+		next := f.expansion[0]
+		f.expansion = f.expansion[1:]
+		return simpleFold(next), true
+	}
+
+	if len(f.remaining) == 0 {
+		return 0, false
+	}
+
+	next, size := utf8.DecodeRuneInString(f.remaining)
+	f.remaining = f.remaining[size:]
+
+	if f.full {
+		if expansion, isExpanded := fullCaseFoldings[next]; isExpanded {
+			f.expansion = expansion[1:]
+			return simpleFold(expansion[0]), true
+		}
+	}
+
+	return simpleFold(next), true
+}
+
+// simpleFold maps r to the smallest lowercase letter of its SimpleFold orbit, or to the smallest
+// member when the orbit has no lowercase letter. Lowercase mappings outside the orbit, such as
+// U+0130 to i, are not applied, matching Unicode simple case folding.
+func simpleFold(r rune) rune {
+	if r <= unicode.MaxASCII {
+		return unicode.ToLower(r)
+	}
+
+	smallest, smallestLower := r, rune(unicode.MaxRune+1)
+
+	for folded := r; ; {
+		smallest = min(smallest, folded)
+
+		if unicode.IsLower(folded) {
+			smallestLower = min(smallestLower, folded)
+		}
+
+		if folded = unicode.SimpleFold(folded); folded == r {
+			break
+		}
+	}
+
+	if smallestLower <= unicode.MaxRune {
+		return smallestLower
+	}
+
+	return smallest
+}
+
+func boolToInt(value bool) int {
+	switch value {
+	case true:
+		return 1
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,103 @@
+package comparator
+
+import (
+	"slices"
+	"testing"
+	"unicode"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/cases"
+)
+
+func TestCaseFoldOrderSimple(t *testing.T) {
+	tests := []struct {
+		name string
+		a    string
+		b    string
+		want int
+	}{
+		{"less_than", "a", "B", -1},
+		{"equal_to", "Hello", "hELLO", 0},
+		{"greater_than", "B", "a", 1},
+		{"prefix_first", "abc", "ABCD", -1},
+		{"kelvin_sign", "Kelvin", "kelvin", 0},
+		{"long_s", "ſtop", "STOP", 0},
+		{"greek_sigma_forms", "ΣΊΣΥΦΟΣ", "σίσυφος", 0},
+		{"cyrillic", "МОСКВА", "москва", 0},
+		{"sharp_s_not_expanded", "straße", "strasse", 1},
+		{"dotted_capital_i_not_folded", "İ", "i", 1},
+		{"dotless_i_not_folded", "ı", "I", 1},
+		{"empty_strings", "", "", 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, CaseFoldOrder(SimpleCaseFolding)(tt.a, tt.b))
+			assert.Equal(t, -tt.want, CaseFoldOrder(SimpleCaseFolding)(tt.b, tt.a), "comparison should be antisymmetric")
+		})
+	}
+}
+
+func TestCaseFoldOrderFull(t *testing.T) {
+	tests := []struct {
+		name string
+		a    string
+		b    string
+		want int
+	}{
+		{"sharp_s", "straße", "STRASSE", 0},
+		{"capital_sharp_s", "STRAẞE", "strasse", 0},
+		{"ligature", "ﬁle", "FILE", 0},
+		{"expansion_then_difference", "ßa", "ssb", -1},
+		{"expansion_prefix", "ß", "ssa", -1},
+		{"simple_folds_still_apply", "K", "k", 0},
+		{"less_than", "masse", "maßen", -1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, CaseFoldOrder(FullCaseFolding)(tt.a, tt.b))
+			assert.Equal(t, -tt.want, CaseFoldOrder(FullCaseFolding)(tt.b, tt.a), "comparison should be antisymmetric")
+		})
+	}
+}
+
+func TestFullCaseFoldingsMatchUnicode(t *testing.T) {
+	folder := cases.Fold()
+
+	for r := rune(0); r <= unicode.MaxRune; r++ {
+		if r >= 0xD800 && r <= 0xDFFF {
+			continue
+		}
+
+		folded := []rune(folder.String(string(r)))
+		expansion, isExpanded := fullCaseFoldings[r]
+
+		if isExpanded != (len(folded) > 1) || isExpanded && !slices.Equal(folded, expansion) {
+			t.Errorf("full folding of %U: got %U, want %U", r, expansion, folded)
+		}
+	}
+}
+
+func TestCaseFoldOrderDoesNotAllocate(t *testing.T) {
+	for _, folding := range []CaseFolding{SimpleCaseFolding, FullCaseFolding} {
+		comparator := CaseFoldOrder(folding)
+		comparator("warm", "up")
+
+		allocations := testing.AllocsPerRun(100, func() {
+			comparator("Straße Ünïcödé ΣΊΣΥΦΟΣ", "STRASSE ünïcödé σίσυφος")
+		})
+
+		assert.Zero(t, allocations, "folding mode %d", folding)
+	}
+}
+
+func TestCaseFoldOrderBy(t *testing.T) {
+	type city struct {
+		name string
+	}
+
+	cities := []city{{"Zürich"}, {"STRASSBURG"}, {"straßburg"}, {"aachen"}}
+
+	slices.SortStableFunc(cities, CaseFoldOrderBy(FullCaseFolding, func(c city) string { return c.name }))
+
+	assert.Equal(t, []city{{"aachen"}, {"STRASSBURG"}, {"straßburg"}, {"Zürich"}}, cities)
+}
@@ -0,0 +1,23 @@
+package comparator
+
+import (
+	"sync"
+
+	"golang.org/x/text/collate"
+	"golang.org/x/text/language"
+)
+
+func CollatedOrder(tag language.Tag, options ...collate.Option) Comparator[string] {
+	collators := sync.Pool{New: func() any { return collate.New(tag, options...) }}
+
+	return func(a string, b string) int {
+		collator := collators.Get().(*collate.Collator)
+		defer collators.Put(collator)
+
+		return collator.CompareString(a, b)
+	}
+}
+
+func CollatedOrderBy[T any](tag language.Tag, selector Selector[T, string], options ...collate.Option) Comparator[T] {
+	return BySelector[T, string](CollatedOrder(tag, options...), selector)
+}
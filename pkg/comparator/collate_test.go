@@ -0,0 +1,69 @@
+package comparator
+
+import (
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/collate"
+	"golang.org/x/text/language"
+)
+
+func TestCollatedOrder(t *testing.T) {
+	tests := []struct {
+		name    string
+		tag     language.Tag
+		options []collate.Option
+		a       string
+		b       string
+		want    int
+	}{
+		{"german_umlaut_near_base_letter", language.German, nil, "Äpfel", "Birnen", -1},
+		{"swedish_umlaut_after_z", language.Swedish, nil, "Äpple", "Zebra", 1},
+		{"accent_sensitive_by_default", language.French, nil, "resume", "résumé", -1},
+		{"accent_insensitive", language.French, []collate.Option{collate.IgnoreDiacritics, collate.IgnoreCase}, "résumé", "Resume", 0},
+		{"loose", language.German, []collate.Option{collate.Loose}, "Café", "cafe", 0},
+		{"case_insensitive", language.English, []collate.Option{collate.IgnoreCase}, "Hello", "hello", 0},
+		{"turkish_dotless_i_case_insensitive", language.Turkish, []collate.Option{collate.IgnoreCase}, "ı", "I", 0},
+		{"turkish_dotted_i_differs", language.Turkish, []collate.Option{collate.IgnoreCase}, "i", "I", 1},
+		{"numeric_option", language.English, []collate.Option{collate.Numeric}, "file2", "file10", -1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, CollatedOrder(tt.tag, tt.options...)(tt.a, tt.b))
+		})
+	}
+}
+
+func TestCollatedOrderBy(t *testing.T) {
+	type customer struct {
+		name string
+	}
+
+	customers := []customer{{"Zoë"}, {"Émile"}, {"eve"}, {"Ana"}}
+
+	slices.SortFunc(customers, CollatedOrderBy(language.French, func(c customer) string { return c.name }))
+
+	assert.Equal(t, []customer{{"Ana"}, {"Émile"}, {"eve"}, {"Zoë"}}, customers)
+}
+
+func TestCollatedOrderIsSafeForConcurrentUse(t *testing.T) {
+	comparator := CollatedOrder(language.German, collate.IgnoreCase)
+
+	var wg sync.WaitGroup
+
+	for worker := 0; worker < 8; worker++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for iteration := 0; iteration < 100; iteration++ {
+				assert.Equal(t, 0, comparator("Äpfel", "äpfel"))
+			}
+		}()
+	}
+
+	wg.Wait()
+}
@@ -0,0 +1,62 @@
+package comparator
+
+type Comparable[T any] interface {
+	Compare(other T) int
+}
+
+type Temporal[T any] interface {
+	Before(other T) bool
+	After(other T) bool
+}
+
+type Cmpable[T any] interface {
+	Cmp(other T) int
+}
+
+func NaturalOrder[T Comparable[T]]() Comparator[T] {
+	return func(a T, b T) int {
+		return sign(a.Compare(b))
+	}
+}
+
+func NaturalOrderBy[T any, C Comparable[C]](selector Selector[T, C]) Comparator[T] {
+	return BySelector[T, C](NaturalOrder[C](), selector)
+}
+
+func TemporalOrder[T Temporal[T]]() Comparator[T] {
+	return func(a T, b T) int {
+		switch {
+		case a.Before(b):
+			return -1
+		case a.After(b):
+			return 1
+		default:
+			return 0
+		}
+	}
+}
+
+func TemporalOrderBy[T any, C Temporal[C]](selector Selector[T, C]) Comparator[T] {
+	return BySelector[T, C](TemporalOrder[C](), selector)
+}
+
+func CmpOrder[T Cmpable[T]]() Comparator[T] {
+	return func(a T, b T) int {
+		return sign(a.Cmp(b))
+	}
+}
+
+func CmpOrderBy[T any, C Cmpable[C]](selector Selector[T, C]) Comparator[T] {
+	return BySelector[T, C](CmpOrder[C](), selector)
+}
+
+func sign(value int) int {
+	switch {
+	case value < 0:
+		return -1
+	case value > 0:
+		return 1
+	default:
+		return 0
+	}
+}
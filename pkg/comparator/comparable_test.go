@@ -0,0 +1,125 @@
+package comparator
+
+import (
+	"math/big"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type version struct {
+	major int
+	minor int
+}
+
+func (v version) Compare(other version) int {
+	switch v.major {
+	case other.major:
+		return (v.minor - other.minor) * 10
+	default:
+		return (v.major - other.major) * 10
+	}
+}
+
+func TestNaturalOrder(t *testing.T) {
+	tests := []struct {
+		name string
+		a    version
+		b    version
+		want int
+	}{
+		{"less_than", version{1, 2}, version{1, 10}, -1},
+		{"equal_to", version{2, 0}, version{2, 0}, 0},
+		{"greater_than", version{3, 0}, version{2, 9}, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, NaturalOrder[version]()(tt.a, tt.b))
+		})
+	}
+}
+
+func TestNaturalOrderBy(t *testing.T) {
+	type release struct {
+		name    string
+		version version
+	}
+
+	releases := []release{{"c", version{2, 0}}, {"a", version{1, 5}}, {"b", version{1, 10}}}
+	slices.SortFunc(releases, NaturalOrderBy(func(r release) version { return r.version }))
+
+	assert.Equal(t, []release{{"a", version{1, 5}}, {"b", version{1, 10}}, {"c", version{2, 0}}}, releases)
+}
+
+func TestTemporalOrder(t *testing.T) {
+	earlier := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	later := earlier.Add(time.Hour)
+	sameInstant := earlier.In(time.FixedZone("UTC+2", 2*60*60))
+
+	tests := []struct {
+		name string
+		a    time.Time
+		b    time.Time
+		want int
+	}{
+		{"less_than", earlier, later, -1},
+		{"equal_to_across_zones", earlier, sameInstant, 0},
+		{"greater_than", later, earlier, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, TemporalOrder[time.Time]()(tt.a, tt.b))
+		})
+	}
+}
+
+func TestTemporalOrderBy(t *testing.T) {
+	type event struct {
+		name string
+		at   time.Time
+	}
+
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	events := []event{{"b", start.Add(time.Minute)}, {"a", start}}
+
+	slices.SortFunc(events, TemporalOrderBy(func(e event) time.Time { return e.at }))
+
+	assert.Equal(t, "a", events[0].name)
+}
+
+func TestCmpOrder(t *testing.T) {
+	huge := new(big.Int).Lsh(big.NewInt(1), 100)
+
+	tests := []struct {
+		name string
+		a    *big.Int
+		b    *big.Int
+		want int
+	}{
+		{"less_than", big.NewInt(-5), huge, -1},
+		{"equal_to", big.NewInt(7), big.NewInt(7), 0},
+		{"greater_than", huge, big.NewInt(1), 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, CmpOrder[*big.Int]()(tt.a, tt.b))
+		})
+	}
+
+	assert.Equal(t, -1, CmpOrder[*big.Float]()(big.NewFloat(0.1), big.NewFloat(0.2)))
+}
+
+func TestCmpOrderBy(t *testing.T) {
+	type account struct {
+		id      string
+		balance *big.Int
+	}
+
+	accounts := []account{{"b", big.NewInt(20)}, {"a", big.NewInt(10)}}
+
+	slices.SortFunc(accounts, CmpOrderBy(func(a account) *big.Int { return a.balance }).Reversed())
+
+	assert.Equal(t, "b", accounts[0].id)
+}
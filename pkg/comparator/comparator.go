@@ -39,6 +39,9 @@ func BySelector[X any, Y any](comparator Comparator[Y], selector Selector[X, Y])
 	}
 }
 
+// AscendingOrder compares with == and <, so a floating point NaN compares as greater than
+// every value, itself included, whichever side it is on. Use NaNFirst, NaNLast or
+// TotalOrderFloat when values may be NaN.
 func AscendingOrder[C constraints.Ordered]() Comparator[C] {
 	return func(a C, b C) int {
 		switch {
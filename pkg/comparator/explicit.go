@@ -0,0 +1,83 @@
+package comparator
+
+import (
+	"errors"
+	"fmt"
+)
+
+type UnknownValues int
+
+const (
+	UnknownValuesLast UnknownValues = iota
+	UnknownValuesFirst
+)
+
+var ErrUnknownValue = errors.New("value is not part of the explicit order")
+
+func ExplicitOrder[T comparable](values ...T) Comparator[T] {
+	return ExplicitOrderWith(UnknownValuesLast, values...)
+}
+
+// ExplicitOrderWith orders values by their first position in values. Unknown values compare
+// equal to each other.
+func ExplicitOrderWith[T comparable](unknown UnknownValues, values ...T) Comparator[T] {
+	positions := explicitPositions(values)
+
+	rank := func(value T) int {
+		switch position, isPresent := positions[value]; {
+		case isPresent:
+			return position
+		case unknown == UnknownValuesFirst:
+			return -1
+		default:
+			return len(values)
+		}
+	}
+
+	return func(a T, b T) int {
+		return sign(rank(a) - rank(b))
+	}
+}
+
+// StrictExplicitOrder returns ExplicitOrder together with a validator that reports values
+// outside of the order with an error wrapping ErrUnknownValue, so input can be checked before
+// sorting.
+func StrictExplicitOrder[T comparable](values ...T) (Comparator[T], func(T) error) {
+	positions := explicitPositions(values)
+
+	validate := func(value T) error {
+		if _, isPresent := positions[value]; !isPresent {
+			return fmt.Errorf("%w: %v", ErrUnknownValue, value)
+		}
+
+		return nil
+	}
+
+	return ExplicitOrder(values...), validate
+}
+
+func ExplicitOrderBy[T any, C comparable](selector Selector[T, C], values ...C) Comparator[T] {
+	return BySelector[T, C](ExplicitOrder(values...), selector)
+}
+
+func ExplicitOrderByWith[T any, C comparable](unknown UnknownValues, selector Selector[T, C], values ...C) Comparator[T] {
+	return BySelector[T, C](ExplicitOrderWith(unknown, values...), selector)
+}
+
+func StrictExplicitOrderBy[T any, C comparable](selector Selector[T, C], values ...C) (Comparator[T], func(T) error) {
+	order, validate := StrictExplicitOrder(values...)
+
+	return BySelector[T, C](order, selector), func(value T) error { return validate(selector(value)) }
+}
+
+func explicitPositions[T comparable](values []T) map[T]int {
+	positions := make(map[T]int, len(values))
+
+	for position, value := range values {
+		if _, isPresent := positions[value]; !isPresent {
+			positions[value] = position
+		}
+	}
+
+	return positions
+}
@@ -0,0 +1,108 @@
+package comparator
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExplicitOrder(t *testing.T) {
+	severity := ExplicitOrder("critical", "high", "medium", "low")
+
+	tests := []struct {
+		name string
+		a    string
+		b    string
+		want int
+	}{
+		{"less_than", "critical", "low", -1},
+		{"equal_to", "high", "high", 0},
+		{"greater_than", "medium", "high", 1},
+		{"unknown_last", "unknown", "low", 1},
+		{"known_before_unknown", "low", "unknown", -1},
+		{"unknowns_equal", "foo", "bar", 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, severity(tt.a, tt.b))
+		})
+	}
+}
+
+func TestExplicitOrderWith(t *testing.T) {
+	tests := []struct {
+		name    string
+		unknown UnknownValues
+		a       string
+		b       string
+		want    int
+	}{
+		{"unknown_last", UnknownValuesLast, "unknown", "low", 1},
+		{"unknown_first", UnknownValuesFirst, "unknown", "critical", -1},
+		{"unknowns_equal_when_first", UnknownValuesFirst, "foo", "bar", 0},
+		{"known_values_with_unknown_first", UnknownValuesFirst, "low", "high", 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ExplicitOrderWith(tt.unknown, "critical", "high", "low")(tt.a, tt.b))
+		})
+	}
+}
+
+func TestStrictExplicitOrder(t *testing.T) {
+	order, validate := StrictExplicitOrder("critical", "high")
+
+	assert.Equal(t, -1, order("critical", "high"))
+	assert.Equal(t, -1, order("high", "urgent"), "unknown values should sort last")
+	assert.NoError(t, validate("high"))
+
+	err := validate("urgent")
+	assert.True(t, errors.Is(err, ErrUnknownValue))
+	assert.EqualError(t, err, "value is not part of the explicit order: urgent")
+}
+
+func TestStrictExplicitOrderBy(t *testing.T) {
+	type incident struct {
+		severity string
+	}
+
+	order, validate := StrictExplicitOrderBy(func(i incident) string { return i.severity }, "critical", "high")
+
+	assert.Equal(t, 1, order(incident{"high"}, incident{"critical"}))
+	assert.NoError(t, validate(incident{"critical"}))
+	assert.True(t, errors.Is(validate(incident{"weird"}), ErrUnknownValue))
+}
+
+func TestExplicitOrderDuplicateValuesUseFirstPosition(t *testing.T) {
+	order := ExplicitOrder(2, 1, 2)
+
+	assert.Equal(t, -1, order(2, 1))
+}
+
+func TestExplicitOrderByComposesWithThen(t *testing.T) {
+	type incident struct {
+		id       int
+		severity string
+	}
+
+	incidents := []incident{{1, "low"}, {2, "critical"}, {3, "weird"}, {4, "high"}, {5, "critical"}}
+
+	slices.SortFunc(incidents, ExplicitOrderBy(func(i incident) string { return i.severity }, "critical", "high", "medium", "low").
+		ThenDescending(AscendingOrderBy(func(i incident) int { return i.id })))
+
+	assert.Equal(t, []incident{{5, "critical"}, {2, "critical"}, {4, "high"}, {1, "low"}, {3, "weird"}}, incidents)
+}
+
+func TestExplicitOrderByWith(t *testing.T) {
+	type task struct {
+		status string
+	}
+
+	tasks := []task{{"done"}, {"blocked"}, {"todo"}}
+
+	slices.SortStableFunc(tasks, ExplicitOrderByWith(UnknownValuesFirst, func(t task) string { return t.status }, "todo", "done"))
+
+	assert.Equal(t, []task{{"blocked"}, {"todo"}, {"done"}}, tasks)
+}
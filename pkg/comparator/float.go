@@ -0,0 +1,78 @@
+package comparator
+
+import (
+	"math"
+	"unsafe"
+
+	"golang.org/x/exp/constraints"
+)
+
+func TotalOrderFloat[F constraints.Float]() Comparator[F] {
+	return func(a F, b F) int {
+		return AscendingOrder[uint64]()(totalOrderKey(a), totalOrderKey(b))
+	}
+}
+
+func TotalOrderFloatBy[T any, F constraints.Float](selector Selector[T, F]) Comparator[T] {
+	return BySelector[T, F](TotalOrderFloat[F](), selector)
+}
+
+func NaNFirst[F constraints.Float]() Comparator[F] {
+	return func(a F, b F) int {
+		switch aIsNaN, bIsNaN := isNaN(a), isNaN(b); {
+		case aIsNaN && bIsNaN:
+			return 0
+		case aIsNaN:
+			return -1
+		case bIsNaN:
+			return 1
+		default:
+			return AscendingOrder[F]()(a, b)
+		}
+	}
+}
+
+func NaNFirstBy[T any, F constraints.Float](selector Selector[T, F]) Comparator[T] {
+	return BySelector[T, F](NaNFirst[F](), selector)
+}
+
+func NaNLast[F constraints.Float]() Comparator[F] {
+	return func(a F, b F) int {
+		switch aIsNaN, bIsNaN := isNaN(a), isNaN(b); {
+		case aIsNaN && bIsNaN:
+			return 0
+		case aIsNaN:
+			return 1
+		case bIsNaN:
+			return -1
+		default:
+			return AscendingOrder[F]()(a, b)
+		}
+	}
+}
+
+func NaNLastBy[T any, F constraints.Float](selector Selector[T, F]) Comparator[T] {
+	return BySelector[T, F](NaNLast[F](), selector)
+}
+
+func isNaN[F constraints.Float](value F) bool {
+	return value != value
+}
+
+func totalOrderKey[F constraints.Float](value F) uint64 {
+	var bits, signBit uint64
+
+	switch unsafe.Sizeof(value) {
+	case 4:
+		bits, signBit = uint64(math.Float32bits(float32(value))), 1<<31
+	default:
+		bits, signBit = math.Float64bits(float64(value)), 1<<63
+	}
+
+	switch bits&signBit != 0 {
+	case true:
+		return ^bits & (signBit<<1 - 1)
+	default:
+		return bits | signBit
+	}
+}
@@ -0,0 +1,126 @@
+package comparator
+
+import (
+	"math"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAscendingOrderWithNaN(t *testing.T) {
+	nan := math.NaN()
+
+	assert.Equal(t, 1, AscendingOrder[float64]()(nan, 1), "NaN compares as greater on the left")
+	assert.Equal(t, 1, AscendingOrder[float64]()(1, nan), "NaN compares as greater on the right as well")
+	assert.Equal(t, 1, AscendingOrder[float64]()(nan, nan), "NaN is not equal to itself")
+}
+
+func TestTotalOrderFloat(t *testing.T) {
+	negativeNaN := math.Copysign(math.NaN(), -1)
+	positiveNaN := math.Copysign(math.NaN(), 1)
+	negativeZero := math.Copysign(0, -1)
+
+	want := []float64{negativeNaN, math.Inf(-1), -1.5, -math.SmallestNonzeroFloat64, negativeZero, 0, math.SmallestNonzeroFloat64, 2, math.Inf(1), positiveNaN}
+
+	values := slices.Clone(want)
+	slices.Reverse(values)
+	slices.SortFunc(values, TotalOrderFloat[float64]())
+
+	for index := range want {
+		assert.Equal(t, math.Float64bits(want[index]), math.Float64bits(values[index]), "position %d", index)
+	}
+}
+
+func TestTotalOrderFloatComparisons(t *testing.T) {
+	nan := math.NaN()
+
+	tests := []struct {
+		name string
+		a    float64
+		b    float64
+		want int
+	}{
+		{"less_than", 1, 2, -1},
+		{"equal_to", 3, 3, 0},
+		{"greater_than", 2, 1, 1},
+		{"negative_zero_before_zero", math.Copysign(0, -1), 0, -1},
+		{"nan_equals_itself", nan, nan, 0},
+		{"nan_after_infinity", nan, math.Inf(1), 1},
+		{"infinity_before_nan", math.Inf(1), nan, -1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, TotalOrderFloat[float64]()(tt.a, tt.b))
+		})
+	}
+}
+
+func TestTotalOrderFloat32(t *testing.T) {
+	negativeNaN := float32(math.Copysign(math.NaN(), -1))
+	values := []float32{float32(math.NaN()), 1, float32(math.Copysign(0, -1)), 0, -1, negativeNaN}
+
+	slices.SortFunc(values, TotalOrderFloat[float32]())
+
+	assert.True(t, isNaN(values[0]) && math.Signbit(float64(values[0])))
+	assert.Equal(t, []float32{-1, 0, 0, 1}, values[1:5])
+	assert.True(t, math.Signbit(float64(values[2])), "negative zero should sort before positive zero")
+	assert.True(t, isNaN(values[5]) && !math.Signbit(float64(values[5])))
+}
+
+func TestNaNFirstAndLast(t *testing.T) {
+	nan := math.NaN()
+
+	tests := []struct {
+		name      string
+		a         float64
+		b         float64
+		wantFirst int
+		wantLast  int
+	}{
+		{"both_nan", nan, nan, 0, 0},
+		{"nan_left", nan, 1, -1, 1},
+		{"nan_right", 1, nan, 1, -1},
+		{"regular_less_than", 1, 2, -1, -1},
+		{"zeros_equal", math.Copysign(0, -1), 0, 0, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.wantFirst, NaNFirst[float64]()(tt.a, tt.b), "NaNFirst")
+			assert.Equal(t, tt.wantLast, NaNLast[float64]()(tt.a, tt.b), "NaNLast")
+		})
+	}
+}
+
+func TestNaNComparatorsAreOrderIndependent(t *testing.T) {
+	nan := math.NaN()
+
+	first := []float64{3, nan, 1, nan, 2}
+	second := []float64{nan, 2, 3, 1, nan}
+
+	slices.SortFunc(first, NaNLast[float64]())
+	slices.SortFunc(second, NaNLast[float64]())
+
+	assert.Equal(t, []float64{1, 2, 3}, first[:3])
+	assert.Equal(t, first[:3], second[:3])
+	assert.True(t, math.IsNaN(first[3]) && math.IsNaN(first[4]))
+}
+
+func TestFloatComparatorsBy(t *testing.T) {
+	type reading struct {
+		sensor string
+		value  float64
+	}
+
+	readings := []reading{{"a", 2}, {"b", math.NaN()}, {"c", 1}}
+	value := func(r reading) float64 { return r.value }
+
+	slices.SortFunc(readings, NaNFirstBy(value))
+	assert.Equal(t, []string{"b", "c", "a"}, []string{readings[0].sensor, readings[1].sensor, readings[2].sensor})
+
+	slices.SortFunc(readings, NaNLastBy(value))
+	assert.Equal(t, []string{"c", "a", "b"}, []string{readings[0].sensor, readings[1].sensor, readings[2].sensor})
+
+	slices.SortFunc(readings, TotalOrderFloatBy(value).Reversed())
+	assert.Equal(t, []string{"b", "a", "c"}, []string{readings[0].sensor, readings[1].sensor, readings[2].sensor})
+}
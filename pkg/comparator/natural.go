@@ -0,0 +1,154 @@
+package comparator
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+func NaturalStringOrder() Comparator[string] {
+	return func(a string, b string) int {
+		return compareNatural(a, b, false)
+	}
+}
+
+func NaturalStringOrderBy[T any](selector Selector[T, string]) Comparator[T] {
+	return BySelector[T, string](NaturalStringOrder(), selector)
+}
+
+func CaseInsensitiveNaturalStringOrder() Comparator[string] {
+	return func(a string, b string) int {
+		return compareNatural(a, b, true)
+	}
+}
+
+func CaseInsensitiveNaturalStringOrderBy[T any](selector Selector[T, string]) Comparator[T] {
+	return BySelector[T, string](CaseInsensitiveNaturalStringOrder(), selector)
+}
+
+// Digit runs of any script compare by numeric value, and a digit compares with any other rune
+// as if it were '0', so numbers sort at the same place whatever their script. When two strings
+// differ only in how their numbers are written, the one whose first differing number has fewer
+// leading zeros sorts first, and remaining ties fall back to comparing the digit runs bytewise.
+func compareNatural(a string, b string, ignoreCase bool) int {
+	tieBreak := 0
+
+	for len(a) > 0 && len(b) > 0 {
+		runeA, sizeA := utf8.DecodeRuneInString(a)
+		runeB, sizeB := utf8.DecodeRuneInString(b)
+
+		digitA, digitB := unicode.IsDigit(runeA), unicode.IsDigit(runeB)
+
+		if digitA && digitB {
+			runA, runB := a[:digitRunEnd(a)], b[:digitRunEnd(b)]
+
+			if result := compareDigitRuns(runA, runB); result != 0 {
+				return result
+			}
+
+			if tieBreak == 0 {
+				tieBreak = AscendingOrder[int]()(leadingZeros(runA), leadingZeros(runB))
+			}
+
+			if tieBreak == 0 {
+				tieBreak = strings.Compare(runA, runB)
+			}
+
+			a, b = a[len(runA):], b[len(runB):]
+
+			continue
+		}
+
+		if ignoreCase {
+			runeA, runeB = unicode.ToLower(runeA), unicode.ToLower(runeB)
+		}
+
+		if digitA {
+			runeA = '0'
+		}
+
+		if digitB {
+			runeB = '0'
+		}
+
+		if runeA != runeB {
+			return AscendingOrder[rune]()(runeA, runeB)
+		}
+
+		a, b = a[sizeA:], b[sizeB:]
+	}
+
+	switch {
+	case len(a) > 0:
+		return 1
+	case len(b) > 0:
+		return -1
+	default:
+		return tieBreak
+	}
+}
+
+func compareDigitRuns(a string, b string) int {
+	a, b = a[leadingZeroBytes(a):], b[leadingZeroBytes(b):]
+
+	if result := AscendingOrder[int]()(utf8.RuneCountInString(a), utf8.RuneCountInString(b)); result != 0 {
+		return result
+	}
+
+	for len(a) > 0 {
+		runeA, sizeA := utf8.DecodeRuneInString(a)
+		runeB, sizeB := utf8.DecodeRuneInString(b)
+
+		if result := AscendingOrder[int]()(digitValue(runeA), digitValue(runeB)); result != 0 {
+			return result
+		}
+
+		a, b = a[sizeA:], b[sizeB:]
+	}
+
+	return 0
+}
+
+func digitRunEnd(value string) int {
+	for index, r := range value {
+		if !unicode.IsDigit(r) {
+			return index
+		}
+	}
+
+	return len(value)
+}
+
+func leadingZeroBytes(run string) int {
+	for index, r := range run {
+		if digitValue(r) != 0 {
+			return index
+		}
+	}
+
+	return len(run)
+}
+
+func leadingZeros(run string) int {
+	return utf8.RuneCountInString(run[:leadingZeroBytes(run)])
+}
+
+func digitValue(digit rune) int {
+	if digit <= unicode.MaxASCII {
+		return int(digit - '0')
+	}
+
+	for _, digits := range unicode.Nd.R16 {
+		if rune(digits.Lo) <= digit && digit <= rune(digits.Hi) {
+			return int(digit-rune(digits.Lo)) % 10
+		}
+	}
+
+	for _, digits := range unicode.Nd.R32 {
+		if rune(digits.Lo) <= digit && digit <= rune(digits.Hi) {
+			return int(digit-rune(digits.Lo)) % 10
+		}
+	}
+
+	return 0
+}
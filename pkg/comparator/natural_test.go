@@ -0,0 +1,113 @@
+package comparator
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNaturalStringOrder(t *testing.T) {
+	tests := []struct {
+		name string
+		a    string
+		b    string
+		want int
+	}{
+		{"numeric_less_than", "file2", "file10", -1},
+		{"numeric_greater_than", "file10", "file2", 1},
+		{"equal_to", "file10", "file10", 0},
+		{"prefix_first", "file", "file1", -1},
+		{"text_after_number", "a1b", "a1c", -1},
+		{"multiple_runs", "v1.10.2", "v1.9.12", 1},
+		{"digits_before_letters", "1a", "a1", -1},
+		{"case_sensitive", "B1", "a1", -1},
+		{"leading_zeros_same_value_fewer_first", "img1", "img01", -1},
+		{"leading_zeros_value_wins", "img02", "img1", 1},
+		{"leading_zero_difference_is_tie_break_only", "x01b", "x1c", -1},
+		{"zero_only_runs", "a0", "a00", -1},
+		{"large_numbers_without_overflow", "id99999999999999999999999", "id100000000000000000000000", -1},
+		{"unicode_digits_by_value", "ticket-٣", "ticket-12", -1},
+		{"mixed_script_run", "n1٢", "n13", -1},
+		{"same_value_different_script", "n٢", "n2", 1},
+		{"unicode_digits_before_letters", "x٣", "xb", -1},
+		{"unicode_digits_after_punctuation", "x٣", "x-", 1},
+		{"empty_strings", "", "", 0},
+		{"empty_first", "", "a", -1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, NaturalStringOrder()(tt.a, tt.b))
+			assert.Equal(t, -tt.want, NaturalStringOrder()(tt.b, tt.a), "comparison should be antisymmetric")
+		})
+	}
+}
+
+func TestNaturalStringOrderIsTransitiveAcrossScripts(t *testing.T) {
+	alphabet := []rune{'0', '1', '9', '٠', '٣', '१', '٢', 'a', 'b', 'A', '_', '-', 'ς', 'é', ' '}
+	random := rand.New(rand.NewSource(45))
+
+	values := make([]string, 60)
+	for index := range values {
+		runes := make([]rune, 1+random.Intn(4))
+		for position := range runes {
+			runes[position] = alphabet[random.Intn(len(alphabet))]
+		}
+		values[index] = string(runes)
+	}
+
+	for _, order := range []Comparator[string]{NaturalStringOrder(), CaseInsensitiveNaturalStringOrder()} {
+		for _, a := range values {
+			for _, b := range values {
+				for _, c := range values {
+					if order(a, b) <= 0 && order(b, c) <= 0 && order(a, c) > 0 {
+						t.Fatalf("%q <= %q and %q <= %q, but %q > %q", a, b, b, c, a, c)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestNaturalStringOrderSort(t *testing.T) {
+	hosts := []string{"web10", "web2", "db1", "web1", "web02", "db10", "db9"}
+
+	slices.SortFunc(hosts, NaturalStringOrder())
+
+	assert.Equal(t, []string{"db1", "db9", "db10", "web1", "web2", "web02", "web10"}, hosts)
+}
+
+func TestCaseInsensitiveNaturalStringOrder(t *testing.T) {
+	tests := []struct {
+		name string
+		a    string
+		b    string
+		want int
+	}{
+		{"equal_ignoring_case", "File2", "file2", 0},
+		{"numeric_order", "FILE2", "file10", -1},
+		{"letters_ignoring_case", "B1", "a1", 1},
+		{"leading_zeros_still_break_ties", "A01", "a1", 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, CaseInsensitiveNaturalStringOrder()(tt.a, tt.b))
+		})
+	}
+}
+
+func TestNaturalStringOrderBy(t *testing.T) {
+	type attachment struct {
+		name string
+	}
+
+	attachments := []attachment{{"Scan10.pdf"}, {"scan9.pdf"}, {"Scan1.pdf"}}
+	name := func(a attachment) string { return a.name }
+
+	slices.SortFunc(attachments, NaturalStringOrderBy(name))
+	assert.Equal(t, []attachment{{"Scan1.pdf"}, {"Scan10.pdf"}, {"scan9.pdf"}}, attachments)
+
+	slices.SortFunc(attachments, CaseInsensitiveNaturalStringOrderBy(name))
+	assert.Equal(t, []attachment{{"Scan1.pdf"}, {"scan9.pdf"}, {"Scan10.pdf"}}, attachments)
+}
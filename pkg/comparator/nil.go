@@ -0,0 +1,72 @@
+package comparator
+
+func NilsFirst[T any](inner Comparator[T]) Comparator[*T] {
+	return func(a *T, b *T) int {
+		switch {
+		case a == nil || b == nil:
+			return nilOrder(a == nil, b == nil)
+		default:
+			return inner(*a, *b)
+		}
+	}
+}
+
+func NilsFirstBy[X any, Y any](inner Comparator[Y], selector Selector[X, *Y]) Comparator[X] {
+	return BySelector[X, *Y](NilsFirst[Y](inner), selector)
+}
+
+func NilsLast[T any](inner Comparator[T]) Comparator[*T] {
+	return func(a *T, b *T) int {
+		switch {
+		case a == nil || b == nil:
+			return -nilOrder(a == nil, b == nil)
+		default:
+			return inner(*a, *b)
+		}
+	}
+}
+
+func NilsLastBy[X any, Y any](inner Comparator[Y], selector Selector[X, *Y]) Comparator[X] {
+	return BySelector[X, *Y](NilsLast[Y](inner), selector)
+}
+
+func NilInterfacesFirst[T any](inner Comparator[T]) Comparator[T] {
+	return func(a T, b T) int {
+		switch aIsNil, bIsNil := any(a) == nil, any(b) == nil; {
+		case aIsNil || bIsNil:
+			return nilOrder(aIsNil, bIsNil)
+		default:
+			return inner(a, b)
+		}
+	}
+}
+
+func NilInterfacesFirstBy[X any, Y any](inner Comparator[Y], selector Selector[X, Y]) Comparator[X] {
+	return BySelector[X, Y](NilInterfacesFirst[Y](inner), selector)
+}
+
+func NilInterfacesLast[T any](inner Comparator[T]) Comparator[T] {
+	return func(a T, b T) int {
+		switch aIsNil, bIsNil := any(a) == nil, any(b) == nil; {
+		case aIsNil || bIsNil:
+			return -nilOrder(aIsNil, bIsNil)
+		default:
+			return inner(a, b)
+		}
+	}
+}
+
+func NilInterfacesLastBy[X any, Y any](inner Comparator[Y], selector Selector[X, Y]) Comparator[X] {
+	return BySelector[X, Y](NilInterfacesLast[Y](inner), selector)
+}
+
+func nilOrder(aIsNil bool, bIsNil bool) int {
+	switch {
+	case aIsNil == bIsNil:
+		return 0
+	case aIsNil:
+		return -1
+	default:
+		return 1
+	}
+}
@@ -0,0 +1,112 @@
+package comparator
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zach-robinson-dev/kollections/pkg/util"
+)
+
+func TestNilsFirst(t *testing.T) {
+	tests := []struct {
+		name string
+		a    *int
+		b    *int
+		want int
+	}{
+		{"both_nil", nil, nil, 0},
+		{"nil_left", nil, util.PointerTo(0), -1},
+		{"nil_right", util.PointerTo(0), nil, 1},
+		{"zero_before_positive", util.PointerTo(0), util.PointerTo(1), -1},
+		{"equal_values", util.PointerTo(3), util.PointerTo(3), 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, NilsFirst(AscendingOrder[int]())(tt.a, tt.b))
+		})
+	}
+}
+
+func TestNilsLast(t *testing.T) {
+	tests := []struct {
+		name  string
+		inner Comparator[int]
+		a     *int
+		b     *int
+		want  int
+	}{
+		{"both_nil", AscendingOrder[int](), nil, nil, 0},
+		{"nil_left", AscendingOrder[int](), nil, util.PointerTo(0), 1},
+		{"nil_right", AscendingOrder[int](), util.PointerTo(0), nil, -1},
+		{"nil_placement_ignores_inner_direction", DescendingOrder[int](), nil, util.PointerTo(0), 1},
+		{"delegates_to_inner", DescendingOrder[int](), util.PointerTo(0), util.PointerTo(1), 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, NilsLast(tt.inner)(tt.a, tt.b))
+		})
+	}
+}
+
+func TestNilsFirstByAndLastBy(t *testing.T) {
+	type measurement struct {
+		name  string
+		score *float64
+	}
+
+	measurements := []measurement{{"a", util.PointerTo(2.5)}, {"b", nil}, {"c", util.PointerTo(0.0)}}
+	score := func(m measurement) *float64 { return m.score }
+	names := func() []string { return []string{measurements[0].name, measurements[1].name, measurements[2].name} }
+
+	slices.SortFunc(measurements, NilsFirstBy(AscendingOrder[float64](), score))
+	assert.Equal(t, []string{"b", "c", "a"}, names(), "unknown scores should sort before a real zero")
+
+	slices.SortFunc(measurements, NilsLastBy(AscendingOrder[float64](), score))
+	assert.Equal(t, []string{"c", "a", "b"}, names())
+}
+
+func TestNilInterfacesFirstAndLast(t *testing.T) {
+	byMessage := func(a error, b error) int { return AscendingOrder[string]()(a.Error(), b.Error()) }
+
+	first, second := fmt.Errorf("a"), fmt.Errorf("b")
+
+	tests := []struct {
+		name      string
+		a         error
+		b         error
+		wantFirst int
+		wantLast  int
+	}{
+		{"both_nil", nil, nil, 0, 0},
+		{"nil_left", nil, first, -1, 1},
+		{"nil_right", first, nil, 1, -1},
+		{"delegates", first, second, -1, -1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.wantFirst, NilInterfacesFirst[error](byMessage)(tt.a, tt.b), "NilInterfacesFirst")
+			assert.Equal(t, tt.wantLast, NilInterfacesLast[error](byMessage)(tt.a, tt.b), "NilInterfacesLast")
+		})
+	}
+}
+
+func TestNilInterfacesFirstByAndLastBy(t *testing.T) {
+	type result struct {
+		id  int
+		err error
+	}
+
+	results := []result{{1, fmt.Errorf("timeout")}, {2, nil}, {3, fmt.Errorf("refused")}}
+	byMessage := func(a error, b error) int { return AscendingOrder[string]()(a.Error(), b.Error()) }
+	selector := func(r result) error { return r.err }
+	ids := func() []int { return []int{results[0].id, results[1].id, results[2].id} }
+
+	slices.SortFunc(results, NilInterfacesFirstBy(byMessage, selector))
+	assert.Equal(t, []int{2, 3, 1}, ids())
+
+	slices.SortFunc(results, NilInterfacesLastBy(byMessage, selector))
+	assert.Equal(t, []int{3, 1, 2}, ids())
+}
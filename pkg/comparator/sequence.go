@@ -0,0 +1,61 @@
+package comparator
+
+import "slices"
+
+type PrefixOrder int
+
+const (
+	ShorterFirst PrefixOrder = iota
+	LongerFirst
+)
+
+func Lexicographic[T any](element Comparator[T], prefixOrder PrefixOrder) Comparator[[]T] {
+	return func(a []T, b []T) int {
+		for index := 0; index < len(a) && index < len(b); index++ {
+			if result := element(a[index], b[index]); result != 0 {
+				return result
+			}
+		}
+
+		switch result := sign(len(a) - len(b)); prefixOrder {
+		case LongerFirst:
+			return -result
+		default:
+			return result
+		}
+	}
+}
+
+func LexicographicBy[T any, E any](element Comparator[E], prefixOrder PrefixOrder, selector Selector[T, []E]) Comparator[T] {
+	return BySelector[T, []E](Lexicographic(element, prefixOrder), selector)
+}
+
+func MapOrder[K comparable, V any](keyComparator Comparator[K], valueComparator Comparator[V]) Comparator[map[K]V] {
+	return func(a map[K]V, b map[K]V) int {
+		keysA, keysB := sortedKeys(a, keyComparator), sortedKeys(b, keyComparator)
+
+		for index := 0; index < len(keysA) && index < len(keysB); index++ {
+			if result := keyComparator(keysA[index], keysB[index]); result != 0 {
+				return result
+			}
+
+			if result := valueComparator(a[keysA[index]], b[keysB[index]]); result != 0 {
+				return result
+			}
+		}
+
+		return sign(len(keysA) - len(keysB))
+	}
+}
+
+func sortedKeys[K comparable, V any](m map[K]V, keyComparator Comparator[K]) []K {
+	keys := make([]K, 0, len(m))
+
+	for key := range m {
+		keys = append(keys, key)
+	}
+
+	slices.SortFunc(keys, keyComparator)
+
+	return keys
+}
@@ -0,0 +1,74 @@
+package comparator
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLexicographic(t *testing.T) {
+	tests := []struct {
+		name            string
+		a               []string
+		b               []string
+		wantShorter     int
+		wantLongerFirst int
+	}{
+		{"both_empty", []string{}, []string{}, 0, 0},
+		{"nil_equals_empty", nil, []string{}, 0, 0},
+		{"element_difference", []string{"usr", "bin"}, []string{"usr", "lib"}, -1, -1},
+		{"element_difference_beats_length", []string{"b"}, []string{"a", "z"}, 1, 1},
+		{"equal", []string{"a", "b"}, []string{"a", "b"}, 0, 0},
+		{"prefix", []string{"usr"}, []string{"usr", "bin"}, -1, 1},
+		{"empty_prefix", []string{}, []string{"a"}, -1, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.wantShorter, Lexicographic(AscendingOrder[string](), ShorterFirst)(tt.a, tt.b), "ShorterFirst")
+			assert.Equal(t, tt.wantLongerFirst, Lexicographic(AscendingOrder[string](), LongerFirst)(tt.a, tt.b), "LongerFirst")
+		})
+	}
+}
+
+func TestLexicographicWithElementComparator(t *testing.T) {
+	paths := [][]string{{"b", "A"}, {"a"}, {"B"}, {"a", "c"}}
+
+	slices.SortFunc(paths, Lexicographic(CaseInsensitiveOrder(), ShorterFirst))
+
+	assert.Equal(t, [][]string{{"a"}, {"a", "c"}, {"B"}, {"b", "A"}}, paths)
+}
+
+func TestLexicographicBy(t *testing.T) {
+	type route struct {
+		segments []string
+	}
+
+	routes := []route{{[]string{"api", "v2"}}, {[]string{"api"}}, {[]string{"api", "v1", "users"}}}
+
+	slices.SortFunc(routes, LexicographicBy(AscendingOrder[string](), LongerFirst, func(r route) []string { return r.segments }))
+
+	assert.Equal(t, []route{{[]string{"api", "v1", "users"}}, {[]string{"api", "v2"}}, {[]string{"api"}}}, routes)
+}
+
+func TestMapOrder(t *testing.T) {
+	tests := []struct {
+		name string
+		a    map[string]int
+		b    map[string]int
+		want int
+	}{
+		{"both_empty", map[string]int{}, map[string]int{}, 0},
+		{"equal", map[string]int{"a": 1, "b": 2}, map[string]int{"b": 2, "a": 1}, 0},
+		{"smaller_first_key", map[string]int{"a": 9}, map[string]int{"b": 1}, -1},
+		{"value_difference", map[string]int{"a": 1, "b": 2}, map[string]int{"a": 1, "b": 3}, -1},
+		{"key_before_value", map[string]int{"a": 1, "c": 0}, map[string]int{"a": 1, "b": 9}, 1},
+		{"subset_first", map[string]int{"a": 1}, map[string]int{"a": 1, "b": 2}, -1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, MapOrder(AscendingOrder[string](), AscendingOrder[int]())(tt.a, tt.b))
+			assert.Equal(t, -tt.want, MapOrder(AscendingOrder[string](), AscendingOrder[int]())(tt.b, tt.a), "comparison should be antisymmetric")
+		})
+	}
+}
@@ -0,0 +1,287 @@
+package comparator
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+var (
+	ErrInvalidSortSpec     = errors.New("invalid sort spec")
+	ErrUnknownSortField    = errors.New("unknown sort field")
+	ErrSortFieldNotAllowed = errors.New("sort field not allowed")
+	ErrUnsupportedSortType = errors.New("unsupported sort field type")
+)
+
+type SortSpecOption func(options *sortSpecOptions)
+
+type sortSpecOptions struct {
+	allowedFields []string
+}
+
+func WithAllowedFields(paths ...string) SortSpecOption {
+	return func(options *sortSpecOptions) {
+		if options.allowedFields == nil {
+			options.allowedFields = make([]string, 0, len(paths))
+		}
+
+		options.allowedFields = append(options.allowedFields, paths...)
+	}
+}
+
+// ParseSortSpec builds a comparator from terms such as "priority desc, createdAt, name ci".
+// Each term names an exported field by json tag or case-insensitive field name, optionally
+// through nested structs with dots, followed by any of asc, desc and ci. Nil pointers along
+// a path sort before non-nil values and NaN sorts after other floats, whether or not the term
+// is descending. Allowed fields are resolved the same way as terms, so a term is accepted only
+// if it names one of those fields.
+func ParseSortSpec[T any](spec string, options ...SortSpecOption) (Comparator[T], error) {
+	settings := sortSpecOptions{}
+
+	for _, option := range options {
+		option(&settings)
+	}
+
+	rootType := reflect.TypeOf((*T)(nil)).Elem()
+
+	allowedFields, err := resolveAllowedFields(rootType, settings.allowedFields)
+	if err != nil {
+		return nil, err
+	}
+
+	var result Comparator[T]
+
+	for _, term := range strings.Split(spec, ",") {
+		termComparator, err := parseSortTerm[T](rootType, strings.Fields(term), allowedFields)
+		if err != nil {
+			return nil, err
+		}
+
+		switch result {
+		case nil:
+			result = termComparator
+		default:
+			result = result.Then(termComparator)
+		}
+	}
+
+	return result, nil
+}
+
+func parseSortTerm[T any](rootType reflect.Type, tokens []string, allowedFields map[string]struct{}) (Comparator[T], error) {
+	if len(tokens) == 0 {
+		return nil, fmt.Errorf("%w: empty sort term", ErrInvalidSortSpec)
+	}
+
+	path, descending, ignoreCase := tokens[0], false, false
+
+	for _, modifier := range tokens[1:] {
+		switch strings.ToLower(modifier) {
+		case "asc":
+			descending = false
+		case "desc":
+			descending = true
+		case "ci":
+			ignoreCase = true
+		default:
+			return nil, fmt.Errorf("%w: unknown modifier %q for %q", ErrInvalidSortSpec, modifier, path)
+		}
+	}
+
+	fieldIndexes, leafType, err := resolveSortPath(rootType, path)
+
+	if _, isAllowed := allowedFields[sortPathKey(fieldIndexes)]; allowedFields != nil && (err != nil || !isAllowed) {
+		return nil, fmt.Errorf("%w: %q", ErrSortFieldNotAllowed, path)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	leafComparator, err := reflectComparator(leafType, ignoreCase, descending)
+	if err != nil {
+		return nil, fmt.Errorf("%w for %q", err, path)
+	}
+
+	termComparator := func(a T, b T) int {
+		valueA, aIsPresent := followSortPath(reflect.ValueOf(&a).Elem(), fieldIndexes)
+		valueB, bIsPresent := followSortPath(reflect.ValueOf(&b).Elem(), fieldIndexes)
+
+		switch {
+		case !aIsPresent || !bIsPresent:
+			return nilOrder(!aIsPresent, !bIsPresent)
+		default:
+			return leafComparator(valueA, valueB)
+		}
+	}
+
+	return termComparator, nil
+}
+
+func resolveAllowedFields(rootType reflect.Type, paths []string) (map[string]struct{}, error) {
+	if paths == nil {
+		return nil, nil
+	}
+
+	allowedFields := make(map[string]struct{}, len(paths))
+
+	for _, path := range paths {
+		fieldIndexes, _, err := resolveSortPath(rootType, path)
+		if err != nil {
+			return nil, fmt.Errorf("allowed field: %w", err)
+		}
+
+		allowedFields[sortPathKey(fieldIndexes)] = struct{}{}
+	}
+
+	return allowedFields, nil
+}
+
+func sortPathKey(fieldIndexes [][]int) string {
+	return fmt.Sprint(fieldIndexes)
+}
+
+func resolveSortPath(rootType reflect.Type, path string) ([][]int, reflect.Type, error) {
+	currentType := rootType
+	fieldIndexes := make([][]int, 0, strings.Count(path, ".")+1)
+
+	for _, segment := range strings.Split(path, ".") {
+		currentType = dereferencedType(currentType)
+
+		if currentType.Kind() != reflect.Struct {
+			return nil, nil, fmt.Errorf("%w: %q does not name a struct field", ErrUnknownSortField, path)
+		}
+
+		field, isFound := findSortField(currentType, segment)
+		if !isFound {
+			return nil, nil, fmt.Errorf("%w: %q", ErrUnknownSortField, path)
+		}
+
+		fieldIndexes = append(fieldIndexes, field.Index)
+		currentType = field.Type
+	}
+
+	return fieldIndexes, dereferencedType(currentType), nil
+}
+
+func findSortField(structType reflect.Type, name string) (reflect.StructField, bool) {
+	var byName *reflect.StructField
+
+	for _, field := range reflect.VisibleFields(structType) {
+		jsonName, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+
+		switch {
+		case !field.IsExported() || field.Anonymous || jsonName == "-":
+		case jsonName == name:
+			return field, true
+		case byName == nil && (strings.EqualFold(jsonName, name) || strings.EqualFold(field.Name, name)):
+			byName = &field
+		}
+	}
+
+	if byName != nil {
+		return *byName, true
+	}
+
+	return reflect.StructField{}, false
+}
+
+func followSortPath(value reflect.Value, fieldIndexes [][]int) (reflect.Value, bool) {
+	for _, index := range fieldIndexes {
+		structValue, isPresent := dereferencedValue(value)
+		if !isPresent {
+			return structValue, false
+		}
+
+		field, err := structValue.FieldByIndexErr(index)
+		if err != nil {
+			return field, false
+		}
+
+		value = field
+	}
+
+	return dereferencedValue(value)
+}
+
+// reflectComparator reverses the leaf order for desc, except for floats, which are negated instead
+// so that NaN stays last.
+func reflectComparator(leafType reflect.Type, ignoreCase bool, descending bool) (Comparator[reflect.Value], error) {
+	ascending, err := ascendingReflectComparator(leafType, ignoreCase)
+
+	switch kind := leafType.Kind(); {
+	case err != nil:
+		return nil, err
+	case !descending:
+		return ascending, nil
+	case (kind == reflect.Float32 || kind == reflect.Float64) && !hasCompareMethod(leafType):
+		return BySelector(NaNLast[float64](), func(value reflect.Value) float64 { return -value.Float() }), nil
+	default:
+		return ascending.Reversed(), nil
+	}
+}
+
+func ascendingReflectComparator(leafType reflect.Type, ignoreCase bool) (Comparator[reflect.Value], error) {
+	if ignoreCase && leafType.Kind() != reflect.String {
+		return nil, fmt.Errorf("%w: ci requires a string field, got %s", ErrInvalidSortSpec, leafType)
+	}
+
+	if hasCompareMethod(leafType) {
+		return func(a reflect.Value, b reflect.Value) int {
+			return sign(int(a.MethodByName("Compare").Call([]reflect.Value{b})[0].Int()))
+		}, nil
+	}
+
+	switch leafType.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return BySelector(AscendingOrder[int64](), reflect.Value.Int), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return BySelector(AscendingOrder[uint64](), reflect.Value.Uint), nil
+	case reflect.Float32, reflect.Float64:
+		return BySelector(NaNLast[float64](), reflect.Value.Float), nil
+	case reflect.Bool:
+		return BySelector(AscendingOrder[int](), func(value reflect.Value) int { return boolToInt(value.Bool()) }), nil
+	case reflect.String:
+		switch ignoreCase {
+		case true:
+			return BySelector(CaseFoldOrder(SimpleCaseFolding), reflect.Value.String), nil
+		default:
+			return BySelector(AscendingOrder[string](), reflect.Value.String), nil
+		}
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedSortType, leafType)
+	}
+}
+
+func hasCompareMethod(leafType reflect.Type) bool {
+	compare, isPresent := leafType.MethodByName("Compare")
+	return isPresent && isCompareMethod(compare.Type, leafType)
+}
+
+func isCompareMethod(methodType reflect.Type, receiverType reflect.Type) bool {
+	return methodType.NumIn() == 2 &&
+		methodType.In(1) == receiverType &&
+		methodType.NumOut() == 1 &&
+		methodType.Out(0).Kind() == reflect.Int
+}
+
+func dereferencedType(valueType reflect.Type) reflect.Type {
+	for valueType.Kind() == reflect.Pointer {
+		valueType = valueType.Elem()
+	}
+
+	return valueType
+}
+
+func dereferencedValue(value reflect.Value) (reflect.Value, bool) {
+	for value.Kind() == reflect.Pointer {
+		if value.IsNil() {
+			return value, false
+		}
+
+		value = value.Elem()
+	}
+
+	return value, true
+}
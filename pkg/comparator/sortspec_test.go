@@ -0,0 +1,148 @@
+package comparator
+
+import (
+	"errors"
+	"math"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type sortSpecOwner struct {
+	Name string `json:"name"`
+}
+
+type sortSpecAudit struct {
+	Revision int
+}
+
+type sortSpecTask struct {
+	sortSpecAudit
+	ID        int       `json:"id"`
+	Priority  uint8     `json:"priority"`
+	Name      string    `json:"name"`
+	Score     float64   `json:"score"`
+	Done      bool      `json:"done"`
+	CreatedAt time.Time `json:"createdAt"`
+	Owner     *sortSpecOwner
+	Secret    string `json:"-"`
+	Tags      []string
+	internal  int
+}
+
+func TestParseSortSpec(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		spec string
+		a    sortSpecTask
+		b    sortSpecTask
+		want int
+	}{
+		{"int_ascending", "id", sortSpecTask{ID: 1}, sortSpecTask{ID: 2}, -1},
+		{"uint_descending", "priority desc", sortSpecTask{Priority: 1}, sortSpecTask{Priority: 2}, 1},
+		{"explicit_ascending", "priority asc", sortSpecTask{Priority: 1}, sortSpecTask{Priority: 2}, -1},
+		{"string_case_sensitive", "name", sortSpecTask{Name: "a"}, sortSpecTask{Name: "B"}, 1},
+		{"string_case_insensitive", "name ci", sortSpecTask{Name: "a"}, sortSpecTask{Name: "B"}, -1},
+		{"modifiers_in_any_order", "name CI DESC", sortSpecTask{Name: "a"}, sortSpecTask{Name: "B"}, 1},
+		{"nan_last", "score", sortSpecTask{Score: math.NaN()}, sortSpecTask{Score: 1}, 1},
+		{"bool_false_first", "done", sortSpecTask{Done: false}, sortSpecTask{Done: true}, -1},
+		{"compare_method", "createdAt", sortSpecTask{CreatedAt: base.Add(time.Hour)}, sortSpecTask{CreatedAt: base}, 1},
+		{"field_name_case_insensitive", "CREATEDAT", sortSpecTask{CreatedAt: base}, sortSpecTask{CreatedAt: base}, 0},
+		{"nested_path", "owner.name", sortSpecTask{Owner: &sortSpecOwner{"b"}}, sortSpecTask{Owner: &sortSpecOwner{"a"}}, 1},
+		{"nil_pointer_first", "owner.name", sortSpecTask{}, sortSpecTask{Owner: &sortSpecOwner{"a"}}, -1},
+		{"nil_pointers_equal", "owner.name", sortSpecTask{}, sortSpecTask{}, 0},
+		{"nil_pointer_first_when_descending", "owner.name desc", sortSpecTask{}, sortSpecTask{Owner: &sortSpecOwner{"a"}}, -1},
+		{"nested_path_descending", "owner.name desc", sortSpecTask{Owner: &sortSpecOwner{"b"}}, sortSpecTask{Owner: &sortSpecOwner{"a"}}, -1},
+		{"float_descending", "score desc", sortSpecTask{Score: 1}, sortSpecTask{Score: 2}, 1},
+		{"nan_last_when_descending", "score desc", sortSpecTask{Score: math.NaN()}, sortSpecTask{Score: 1}, 1},
+		{"compare_method_descending", "createdAt desc", sortSpecTask{CreatedAt: base.Add(time.Hour)}, sortSpecTask{CreatedAt: base}, -1},
+		{"promoted_field", "revision", sortSpecTask{sortSpecAudit: sortSpecAudit{2}}, sortSpecTask{sortSpecAudit: sortSpecAudit{1}}, 1},
+		{"then_next_term", "priority desc, name", sortSpecTask{Priority: 1, Name: "b"}, sortSpecTask{Priority: 1, Name: "a"}, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			compare, err := ParseSortSpec[sortSpecTask](tt.spec)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, compare(tt.a, tt.b))
+		})
+	}
+}
+
+func TestParseSortSpecSortsPointers(t *testing.T) {
+	tasks := []*sortSpecTask{
+		{ID: 1, Priority: 1, Name: "beta"},
+		{ID: 2, Priority: 3, Name: "alpha"},
+		{ID: 3, Priority: 1, Name: "Alpha"},
+	}
+
+	compare, err := ParseSortSpec[*sortSpecTask]("priority desc, name ci, id")
+	assert.NoError(t, err)
+
+	slices.SortFunc(tasks, compare)
+
+	ids := make([]int, 0, len(tasks))
+	for _, task := range tasks {
+		ids = append(ids, task.ID)
+	}
+	assert.Equal(t, []int{2, 3, 1}, ids)
+}
+
+func TestParseSortSpecErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    string
+		options []SortSpecOption
+		want    error
+	}{
+		{"empty_spec", "", nil, ErrInvalidSortSpec},
+		{"empty_term", "id,,name", nil, ErrInvalidSortSpec},
+		{"unknown_modifier", "id sideways", nil, ErrInvalidSortSpec},
+		{"ci_on_non_string", "id ci", nil, ErrInvalidSortSpec},
+		{"unknown_field", "missing", nil, ErrUnknownSortField},
+		{"unexported_field", "internal", nil, ErrUnknownSortField},
+		{"json_ignored_field", "secret", nil, ErrUnknownSortField},
+		{"path_through_scalar", "id.value", nil, ErrUnknownSortField},
+		{"unsupported_type", "tags", nil, ErrUnsupportedSortType},
+		{"unsupported_struct", "owner", nil, ErrUnsupportedSortType},
+		{"not_allowed", "name", []SortSpecOption{WithAllowedFields("id", "owner.name")}, ErrSortFieldNotAllowed},
+		{"unknown_field_not_allowed", "missing", []SortSpecOption{WithAllowedFields("id")}, ErrSortFieldNotAllowed},
+		{"empty_allow_list", "id", []SortSpecOption{WithAllowedFields()}, ErrSortFieldNotAllowed},
+		{"unknown_allowed_field", "id", []SortSpecOption{WithAllowedFields("missing")}, ErrUnknownSortField},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			compare, err := ParseSortSpec[sortSpecTask](tt.spec, tt.options...)
+			assert.Nil(t, compare)
+			assert.True(t, errors.Is(err, tt.want), "got %v", err)
+		})
+	}
+}
+
+func TestParseSortSpecAllowedFields(t *testing.T) {
+	compare, err := ParseSortSpec[sortSpecTask]("ID, Owner.Name desc", WithAllowedFields("id", "owner.name"))
+	assert.NoError(t, err)
+	assert.Equal(t, 1, compare(sortSpecTask{ID: 1, Owner: &sortSpecOwner{"a"}}, sortSpecTask{ID: 1, Owner: &sortSpecOwner{"b"}}))
+}
+
+func TestParseSortSpecAllowedFieldsMatchResolvedField(t *testing.T) {
+	type account struct {
+		Secret int `json:"hidden"`
+		Public int `json:"secret"`
+	}
+
+	_, err := ParseSortSpec[account]("Secret", WithAllowedFields("secret"))
+	assert.True(t, errors.Is(err, ErrSortFieldNotAllowed), "got %v", err)
+
+	compare, err := ParseSortSpec[account]("secret", WithAllowedFields("secret"))
+	assert.NoError(t, err)
+	assert.Equal(t, -1, compare(account{Secret: 2, Public: 1}, account{Secret: 1, Public: 2}))
+}
+
+func TestParseSortSpecRejectsNonStructType(t *testing.T) {
+	_, err := ParseSortSpec[int]("value")
+	assert.True(t, errors.Is(err, ErrUnknownSortField))
+}
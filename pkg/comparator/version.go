@@ -0,0 +1,196 @@
+package comparator
+
+import "strings"
+
+// SemVerOrder orders by SemVer 2.0 precedence, accepting an optional leading "v" and
+// ignoring build metadata. Invalid versions sort after all valid ones, ordered bytewise.
+func SemVerOrder() Comparator[string] {
+	return func(a string, b string) int {
+		versionA, aIsValid := parseSemVer(a)
+		versionB, bIsValid := parseSemVer(b)
+
+		switch {
+		case !aIsValid || !bIsValid:
+			return compareInvalidLast(a, aIsValid, b, bIsValid)
+		default:
+			return versionA.compare(versionB)
+		}
+	}
+}
+
+func SemVerOrderBy[T any](selector Selector[T, string]) Comparator[T] {
+	return BySelector[T, string](SemVerOrder(), selector)
+}
+
+// DottedNumericOrder orders dot-separated runs of ASCII digits such as "1.10.2" or OIDs
+// component by component, with a shorter prefix first. Invalid strings sort after all
+// valid ones, ordered bytewise.
+func DottedNumericOrder() Comparator[string] {
+	return func(a string, b string) int {
+		aIsValid, bIsValid := isDottedNumeric(a), isDottedNumeric(b)
+
+		switch {
+		case !aIsValid || !bIsValid:
+			return compareInvalidLast(a, aIsValid, b, bIsValid)
+		default:
+			return compareDotted(a, b, compareNumeric)
+		}
+	}
+}
+
+func DottedNumericOrderBy[T any](selector Selector[T, string]) Comparator[T] {
+	return BySelector[T, string](DottedNumericOrder(), selector)
+}
+
+type semVer struct {
+	core       string
+	prerelease string
+}
+
+func parseSemVer(value string) (semVer, bool) {
+	value = strings.TrimPrefix(value, "v")
+
+	value, build, hasBuild := strings.Cut(value, "+")
+	if hasBuild && !allIdentifiers(build, isAlphanumericIdentifier) {
+		return semVer{}, false
+	}
+
+	core, prerelease, hasPrerelease := strings.Cut(value, "-")
+	if hasPrerelease && !allIdentifiers(prerelease, isPrereleaseIdentifier) {
+		return semVer{}, false
+	}
+
+	if strings.Count(core, ".") != 2 || !allIdentifiers(core, isCanonicalNumber) {
+		return semVer{}, false
+	}
+
+	return semVer{core: core, prerelease: prerelease}, true
+}
+
+func (v semVer) compare(other semVer) int {
+	if result := compareDotted(v.core, other.core, compareNumeric); result != 0 {
+		return result
+	}
+
+	switch {
+	case v.prerelease == "" && other.prerelease == "":
+		return 0
+	case v.prerelease == "":
+		return 1
+	case other.prerelease == "":
+		return -1
+	default:
+		return compareDotted(v.prerelease, other.prerelease, comparePrereleaseIdentifiers)
+	}
+}
+
+func comparePrereleaseIdentifiers(a string, b string) int {
+	aIsNumeric, bIsNumeric := isDigits(a), isDigits(b)
+
+	switch {
+	case aIsNumeric && bIsNumeric:
+		return compareNumeric(a, b)
+	case aIsNumeric:
+		return -1
+	case bIsNumeric:
+		return 1
+	default:
+		return strings.Compare(a, b)
+	}
+}
+
+func compareDotted(a string, b string, compareComponents Comparator[string]) int {
+	for {
+		componentA, restA, moreA := strings.Cut(a, ".")
+		componentB, restB, moreB := strings.Cut(b, ".")
+
+		if result := compareComponents(componentA, componentB); result != 0 {
+			return result
+		}
+
+		switch {
+		case moreA && moreB:
+			a, b = restA, restB
+		default:
+			return boolToInt(moreA) - boolToInt(moreB)
+		}
+	}
+}
+
+func compareNumeric(a string, b string) int {
+	a, b = strings.TrimLeft(a, "0"), strings.TrimLeft(b, "0")
+
+	switch {
+	case len(a) != len(b):
+		return sign(len(a) - len(b))
+	default:
+		return strings.Compare(a, b)
+	}
+}
+
+func compareInvalidLast(a string, aIsValid bool, b string, bIsValid bool) int {
+	switch {
+	case aIsValid == bIsValid:
+		return strings.Compare(a, b)
+	case aIsValid:
+		return -1
+	default:
+		return 1
+	}
+}
+
+func isDottedNumeric(value string) bool {
+	return allIdentifiers(value, isDigits)
+}
+
+func allIdentifiers(value string, isValid func(identifier string) bool) bool {
+	for {
+		identifier, rest, hasMore := strings.Cut(value, ".")
+
+		if !isValid(identifier) {
+			return false
+		}
+
+		if !hasMore {
+			return true
+		}
+
+		value = rest
+	}
+}
+
+func isCanonicalNumber(identifier string) bool {
+	return isDigits(identifier) && (identifier == "0" || identifier[0] != '0')
+}
+
+func isPrereleaseIdentifier(identifier string) bool {
+	return isAlphanumericIdentifier(identifier) && (!isDigits(identifier) || isCanonicalNumber(identifier))
+}
+
+func isAlphanumericIdentifier(identifier string) bool {
+	if identifier == "" {
+		return false
+	}
+
+	for _, r := range identifier {
+		if !(r >= '0' && r <= '9' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r == '-') {
+			return false
+		}
+	}
+
+	return true
+}
+
+func isDigits(identifier string) bool {
+	if identifier == "" {
+		return false
+	}
+
+	for _, r := range identifier {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+
+	return true
+}
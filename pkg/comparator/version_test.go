@@ -0,0 +1,108 @@
+package comparator
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSemVerOrder(t *testing.T) {
+	tests := []struct {
+		name string
+		a    string
+		b    string
+		want int
+	}{
+		{"major", "1.0.0", "2.0.0", -1},
+		{"minor_numeric_not_lexical", "1.2.0", "1.10.0", -1},
+		{"patch", "1.0.10", "1.0.9", 1},
+		{"equal", "1.2.3", "1.2.3", 0},
+		{"prerelease_before_release", "1.0.0-alpha", "1.0.0", -1},
+		{"prerelease_identifiers", "1.0.0-alpha", "1.0.0-alpha.1", -1},
+		{"numeric_before_alphanumeric", "1.0.0-alpha.1", "1.0.0-alpha.beta", -1},
+		{"alphanumeric_lexical", "1.0.0-alpha.beta", "1.0.0-beta", -1},
+		{"numeric_identifiers", "1.0.0-beta.2", "1.0.0-beta.11", -1},
+		{"rc_before_release", "1.0.0-rc.1", "1.0.0", -1},
+		{"build_metadata_ignored", "1.0.0+build.1", "1.0.0+build.2", 0},
+		{"build_metadata_with_prerelease", "1.0.0-rc.1+001", "1.0.0-rc.1", 0},
+		{"v_prefix_accepted", "v1.2.3", "1.2.4", -1},
+		{"large_numbers", "1.0.99999999999999999999", "1.0.100000000000000000000", -1},
+		{"valid_before_invalid", "99.0.0", "1.0", -1},
+		{"invalid_bytewise", "latest", "1.0", 1},
+		{"leading_zero_invalid", "01.0.0", "2.0.0", 1},
+		{"prerelease_leading_zero_invalid", "1.0.0-01", "2.0.0", 1},
+		{"empty_prerelease_identifier_invalid", "1.0.0-alpha..1", "2.0.0", 1},
+		{"empty_build_invalid", "1.0.0+", "2.0.0", 1},
+		{"hyphen_in_prerelease", "1.0.0-x-y", "1.0.0-x-z", -1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, SemVerOrder()(tt.a, tt.b))
+			assert.Equal(t, -tt.want, SemVerOrder()(tt.b, tt.a), "comparison should be antisymmetric")
+		})
+	}
+}
+
+func TestSemVerOrderSpecExample(t *testing.T) {
+	want := []string{"1.0.0-alpha", "1.0.0-alpha.1", "1.0.0-alpha.beta", "1.0.0-beta", "1.0.0-beta.2", "1.0.0-beta.11", "1.0.0-rc.1", "1.0.0"}
+
+	versions := slices.Clone(want)
+	slices.Reverse(versions)
+	slices.SortFunc(versions, SemVerOrder())
+
+	assert.Equal(t, want, versions)
+}
+
+func TestSemVerOrderBy(t *testing.T) {
+	type artifact struct {
+		version string
+	}
+
+	artifacts := []artifact{{"garbage"}, {"2.0.0"}, {"1.10.0"}, {"1.9.0-rc.1"}, {"1.9.0"}}
+
+	slices.SortFunc(artifacts, SemVerOrderBy(func(a artifact) string { return a.version }))
+
+	assert.Equal(t, []artifact{{"1.9.0-rc.1"}, {"1.9.0"}, {"1.10.0"}, {"2.0.0"}, {"garbage"}}, artifacts)
+}
+
+func TestDottedNumericOrder(t *testing.T) {
+	tests := []struct {
+		name string
+		a    string
+		b    string
+		want int
+	}{
+		{"numeric_components", "1.9.2", "1.10.2", -1},
+		{"equal", "1.2.3", "1.2.3", 0},
+		{"leading_zeros_equal", "1.02", "1.2", 0},
+		{"prefix_first", "1.2", "1.2.0", -1},
+		{"oid", "1.3.6.1.4.1.311", "1.3.6.1.4.1.2", 1},
+		{"single_component", "10", "9", 1},
+		{"very_long_component", "1.99999999999999999999", "1.100000000000000000000", -1},
+		{"valid_before_invalid", "999", "1.a", -1},
+		{"empty_component_invalid", "1..2", "1.2", 1},
+		{"trailing_dot_invalid", "1.2.", "1.2", 1},
+		{"empty_string_invalid", "", "0", 1},
+		{"invalid_bytewise", "abc", "abd", -1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, DottedNumericOrder()(tt.a, tt.b))
+			assert.Equal(t, -tt.want, DottedNumericOrder()(tt.b, tt.a), "comparison should be antisymmetric")
+		})
+	}
+}
+
+func TestDottedNumericOrderBy(t *testing.T) {
+	type plugin struct {
+		name    string
+		version string
+	}
+
+	plugins := []plugin{{"a", "1.10"}, {"b", "1.9.1"}, {"c", "1.9"}}
+
+	slices.SortFunc(plugins, DottedNumericOrderBy(func(p plugin) string { return p.version }))
+
+	assert.Equal(t, []plugin{{"c", "1.9"}, {"b", "1.9.1"}, {"a", "1.10"}}, plugins)
+}
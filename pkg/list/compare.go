@@ -0,0 +1,7 @@
+package list
+
+import "github.com/zach-robinson-dev/kollections/pkg/comparator"
+
+func Lexicographic[T any](element comparator.Comparator[T], prefixOrder comparator.PrefixOrder) comparator.Comparator[List[T]] {
+	return comparator.BySelector(comparator.Lexicographic(element, prefixOrder), func(list List[T]) []T { return list })
+}
@@ -0,0 +1,29 @@
+package list
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zach-robinson-dev/kollections/pkg/comparator"
+)
+
+func TestLexicographic(t *testing.T) {
+	tests := []struct {
+		name        string
+		prefixOrder comparator.PrefixOrder
+		want        List[List[int]]
+	}{
+		{"Shorter first", comparator.ShorterFirst, List[List[int]]{{}, {1}, {1, 2}, {1, 3}, {2}}},
+		{"Longer first", comparator.LongerFirst, List[List[int]]{{1, 2}, {1, 3}, {1}, {2}, {}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			keys := List[List[int]]{{2}, {1, 3}, {}, {1}, {1, 2}}
+			slices.SortFunc(keys, Lexicographic(comparator.AscendingOrder[int](), tt.prefixOrder))
+			assert.Equal(t, tt.want, keys, "Lexicographic() should order lists as expected")
+		})
+	}
+}
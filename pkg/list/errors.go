@@ -0,0 +1,31 @@
+package list
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrNoSuchElement    = errors.New("no such element")
+	ErrMultipleElements = errors.New("list contains more than one element")
+	ErrEmptyList        = fmt.Errorf("%w: list is empty", ErrNoSuchElement)
+	ErrIndexOutOfBounds = errors.New("index out of bounds")
+	ErrInvalidWindow    = errors.New("window size and step must be positive")
+)
+
+type IndexOutOfBoundsError struct {
+	Index  int
+	Length int
+}
+
+func (e *IndexOutOfBoundsError) Error() string {
+	return fmt.Sprintf("%s: index %d, length %d", ErrIndexOutOfBounds, e.Index, e.Length)
+}
+
+func (e *IndexOutOfBoundsError) Is(target error) bool {
+	return target == ErrIndexOutOfBounds
+}
+
+func indexOutOfBounds(index int, length int) error {
+	return &IndexOutOfBoundsError{Index: index, Length: length}
+}
@@ -0,0 +1,57 @@
+package list
+
+import (
+	"golang.org/x/exp/constraints"
+
+	"github.com/zach-robinson-dev/kollections/pkg/comparator"
+)
+
+func MinBy[T any, C constraints.Ordered](list List[T], selector comparator.Selector[T, C]) (T, error) {
+	return list.MinWithE(comparator.AscendingOrderBy(selector))
+}
+
+func MaxBy[T any, C constraints.Ordered](list List[T], selector comparator.Selector[T, C]) (T, error) {
+	return list.MaxWithE(comparator.AscendingOrderBy(selector))
+}
+
+func MinOf[T any, C constraints.Ordered](list List[T], selector comparator.Selector[T, C]) (C, error) {
+	return selectExtreme(MinBy[T, C], list, selector)
+}
+
+func MaxOf[T any, C constraints.Ordered](list List[T], selector comparator.Selector[T, C]) (C, error) {
+	return selectExtreme(MaxBy[T, C], list, selector)
+}
+
+func (l *List[T]) MinMaxWith(comparator comparator.Comparator[T]) (T, T, error) {
+	if len(*l) == 0 {
+		var zero T
+		return zero, zero, ErrEmptyList
+	}
+
+	minEntry, maxEntry := (*l)[0], (*l)[0]
+
+	for _, element := range (*l)[1:] {
+		switch {
+		case comparator(element, minEntry) < 0:
+			minEntry = element
+		case comparator(element, maxEntry) > 0:
+			maxEntry = element
+		}
+	}
+
+	return minEntry, maxEntry, nil
+}
+
+func selectExtreme[T any, C constraints.Ordered](
+	extreme func(List[T], comparator.Selector[T, C]) (T, error),
+	list List[T],
+	selector comparator.Selector[T, C],
+) (C, error) {
+	element, err := extreme(list, selector)
+	if err != nil {
+		var zero C
+		return zero, err
+	}
+
+	return selector(element), nil
+}
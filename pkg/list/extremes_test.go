@@ -0,0 +1,86 @@
+package list
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zach-robinson-dev/kollections/pkg/comparator"
+)
+
+type employee struct {
+	name   string
+	salary int
+}
+
+func TestMinByAndMaxBy(t *testing.T) {
+	bySalary := func(e employee) int { return e.salary }
+
+	tests := []struct {
+		name    string
+		list    List[employee]
+		wantMin employee
+		wantMax employee
+		wantErr error
+	}{
+		{"Empty list", List[employee]{}, employee{}, employee{}, ErrEmptyList},
+		{"Distinct values", List[employee]{{"a", 30}, {"b", 10}, {"c", 20}}, employee{"b", 10}, employee{"a", 30}, nil},
+		{"Ties keep first element", List[employee]{{"a", 10}, {"b", 10}}, employee{"a", 10}, employee{"a", 10}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			minimum, err := MinBy(tt.list, bySalary)
+			assert.ErrorIs(t, err, tt.wantErr, "MinBy() should return expected error")
+			assert.Equal(t, tt.wantMin, minimum, "MinBy() should return expected result")
+
+			maximum, err := MaxBy(tt.list, bySalary)
+			assert.ErrorIs(t, err, tt.wantErr, "MaxBy() should return expected error")
+			assert.Equal(t, tt.wantMax, maximum, "MaxBy() should return expected result")
+		})
+	}
+}
+
+func TestMinOfAndMaxOf(t *testing.T) {
+	list := List[employee]{{"ann", 30}, {"bo", 10}, {"cyril", 20}}
+	byNameLength := func(e employee) int { return len(e.name) }
+
+	minimum, err := MinOf(list, byNameLength)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, minimum)
+
+	maximum, err := MaxOf(list, byNameLength)
+	assert.NoError(t, err)
+	assert.Equal(t, 5, maximum)
+
+	_, err = MinOf(List[employee]{}, byNameLength)
+	assert.ErrorIs(t, err, ErrEmptyList)
+
+	_, err = MaxOf(List[employee]{}, byNameLength)
+	assert.ErrorIs(t, err, ErrEmptyList)
+}
+
+func TestMinMaxWith(t *testing.T) {
+	tests := []struct {
+		name    string
+		list    List[int]
+		wantMin int
+		wantMax int
+		wantErr error
+	}{
+		{"Empty list", List[int]{}, 0, 0, ErrEmptyList},
+		{"Single element", List[int]{4}, 4, 4, nil},
+		{"Ascending input", List[int]{1, 2, 3}, 1, 3, nil},
+		{"Descending input", List[int]{3, 2, 1}, 1, 3, nil},
+		{"Unordered input", List[int]{5, -2, 9, 0}, -2, 9, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			minimum, maximum, err := tt.list.MinMaxWith(comparator.AscendingOrder[int]())
+			assert.ErrorIs(t, err, tt.wantErr, "MinMaxWith() should return expected error")
+			assert.Equal(t, tt.wantMin, minimum, "MinMaxWith() should return expected minimum")
+			assert.Equal(t, tt.wantMax, maximum, "MinMaxWith() should return expected maximum")
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package list
+
+func FlatMap[T any, R any](list List[T], transform TransformFunc[T, List[R]]) List[R] {
+	result := make(List[R], 0, len(list))
+
+	for _, element := range list {
+		result = append(result, transform(element)...)
+	}
+
+	return result
+}
+
+func Flatten[T any](lists List[List[T]]) List[T] {
+	return FlatMap(lists, func(list List[T]) List[T] { return list })
+}
+
+func MapNotNil[T any, R any](list List[T], transform TransformFunc[T, *R]) List[R] {
+	result := make(List[R], 0, len(list))
+
+	for _, element := range list {
+		if transformed := transform(element); transformed != nil {
+			result = append(result, *transformed)
+		}
+	}
+
+	return result
+}
+
+func FilterNotNil[T any](list List[*T]) List[*T] {
+	return list.Filter(func(item *T) bool { return item != nil })
+}
+
+func FilterIsInstance[R any, T any](list List[T]) List[R] {
+	result := make(List[R], 0, len(list))
+
+	for _, element := range list {
+		if instance, ok := any(element).(R); ok {
+			result = append(result, instance)
+		}
+	}
+
+	return result
+}
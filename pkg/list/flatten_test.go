@@ -0,0 +1,91 @@
+package list
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zach-robinson-dev/kollections/pkg/util"
+)
+
+func TestFlatMap(t *testing.T) {
+	tests := []struct {
+		name string
+		list List[int]
+		want List[int]
+	}{
+		{"Empty list", List[int]{}, List[int]{}},
+		{"Expands elements", List[int]{1, 2, 3}, List[int]{1, 2, 2, 3, 3, 3}},
+		{"Drops elements mapped to empty lists", List[int]{0, 1, 0}, List[int]{1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := FlatMap(tt.list, func(item int) List[int] {
+				repeated := make(List[int], item)
+				repeated.Fill(item)
+				return repeated
+			})
+			assert.Equal(t, tt.want, got, "FlatMap() should return expected result")
+		})
+	}
+}
+
+func TestFlatten(t *testing.T) {
+	tests := []struct {
+		name  string
+		lists List[List[string]]
+		want  List[string]
+	}{
+		{"No lists", List[List[string]]{}, List[string]{}},
+		{"Nested lists", List[List[string]]{{"a"}, {}, {"b", "c"}, nil}, List[string]{"a", "b", "c"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Flatten(tt.lists), "Flatten() should return expected result")
+		})
+	}
+}
+
+func TestMapNotNil(t *testing.T) {
+	got := MapNotNil(List[string]{"1", "x", "3"}, func(item string) *int {
+		switch parsed, err := strconv.Atoi(item); err {
+		case nil:
+			return &parsed
+		default:
+			return nil
+		}
+	})
+
+	assert.Equal(t, List[int]{1, 3}, got, "MapNotNil() should drop nil results")
+}
+
+func TestFilterNotNil(t *testing.T) {
+	first, second := util.PointerTo(1), util.PointerTo(2)
+
+	got := FilterNotNil(List[*int]{nil, first, nil, second})
+
+	assert.Equal(t, List[*int]{first, second}, got, "FilterNotNil() should drop nil pointers")
+	assert.Same(t, first, got[0], "FilterNotNil() should keep the original pointers")
+}
+
+func TestFilterIsInstance(t *testing.T) {
+	decoded := List[any]{"a", 1.5, nil, "b", map[string]any{"k": "v"}, true, float64(2)}
+
+	assert.Equal(t, List[string]{"a", "b"}, FilterIsInstance[string](decoded))
+	assert.Equal(t, List[float64]{1.5, 2}, FilterIsInstance[float64](decoded))
+	assert.Equal(t, List[map[string]any]{{"k": "v"}}, FilterIsInstance[map[string]any](decoded))
+	assert.Equal(t, List[int]{}, FilterIsInstance[int](decoded))
+}
+
+func TestFilterIsInstanceWithInterfaceTarget(t *testing.T) {
+	values := List[any]{1, "two", fmt.Errorf("three")}
+
+	got := FilterIsInstance[error](values)
+
+	assert.Len(t, got, 1)
+	assert.EqualError(t, got[0], "three")
+}
@@ -0,0 +1,106 @@
+package list
+
+func Fold[T any, R any](list List[T], initial R, operation AccumulatorFunc[T, R]) R {
+	return FoldIndexed(list, initial, func(_ int, accumulator R, item T) R {
+		return operation(accumulator, item)
+	})
+}
+
+func FoldIndexed[T any, R any](list List[T], initial R, operation IndexedAccumulatorFunc[T, R]) R {
+	accumulator := initial
+
+	for index, element := range list {
+		accumulator = operation(index, accumulator, element)
+	}
+
+	return accumulator
+}
+
+func FoldRight[T any, R any](list List[T], initial R, operation AccumulatorFunc[T, R]) R {
+	return FoldRightIndexed(list, initial, func(_ int, accumulator R, item T) R {
+		return operation(accumulator, item)
+	})
+}
+
+func FoldRightIndexed[T any, R any](list List[T], initial R, operation IndexedAccumulatorFunc[T, R]) R {
+	accumulator := initial
+
+	for index := len(list) - 1; index >= 0; index-- {
+		accumulator = operation(index, accumulator, list[index])
+	}
+
+	return accumulator
+}
+
+func (l *List[T]) Reduce(operation AccumulatorFunc[T, T]) (T, error) {
+	return l.ReduceIndexed(func(_ int, accumulator T, item T) T {
+		return operation(accumulator, item)
+	})
+}
+
+func (l *List[T]) ReduceIndexed(operation IndexedAccumulatorFunc[T, T]) (T, error) {
+	if len(*l) == 0 {
+		var zero T
+		return zero, ErrEmptyList
+	}
+
+	accumulator := (*l)[0]
+
+	for index := 1; index < len(*l); index++ {
+		accumulator = operation(index, accumulator, (*l)[index])
+	}
+
+	return accumulator, nil
+}
+
+func Scan[T any, R any](list List[T], initial R, operation AccumulatorFunc[T, R]) List[R] {
+	return ScanIndexed(list, initial, func(_ int, accumulator R, item T) R {
+		return operation(accumulator, item)
+	})
+}
+
+func ScanIndexed[T any, R any](list List[T], initial R, operation IndexedAccumulatorFunc[T, R]) List[R] {
+	result := make(List[R], 0, len(list)+1)
+	result = append(result, initial)
+
+	accumulator := initial
+
+	for index, element := range list {
+		accumulator = operation(index, accumulator, element)
+		result = append(result, accumulator)
+	}
+
+	return result
+}
+
+func RunningFold[T any, R any](list List[T], initial R, operation AccumulatorFunc[T, R]) List[R] {
+	return Scan(list, initial, operation)
+}
+
+func RunningFoldIndexed[T any, R any](list List[T], initial R, operation IndexedAccumulatorFunc[T, R]) List[R] {
+	return ScanIndexed(list, initial, operation)
+}
+
+func (l *List[T]) RunningReduce(operation AccumulatorFunc[T, T]) List[T] {
+	return l.RunningReduceIndexed(func(_ int, accumulator T, item T) T {
+		return operation(accumulator, item)
+	})
+}
+
+func (l *List[T]) RunningReduceIndexed(operation IndexedAccumulatorFunc[T, T]) List[T] {
+	result := make(List[T], 0, len(*l))
+
+	if len(*l) == 0 {
+		return result
+	}
+
+	accumulator := (*l)[0]
+	result = append(result, accumulator)
+
+	for index := 1; index < len(*l); index++ {
+		accumulator = operation(index, accumulator, (*l)[index])
+		result = append(result, accumulator)
+	}
+
+	return result
+}
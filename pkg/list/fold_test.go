@@ -0,0 +1,150 @@
+package list
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFold(t *testing.T) {
+	tests := []struct {
+		name    string
+		list    List[int]
+		initial string
+		want    string
+	}{
+		{"Empty list", List[int]{}, "start", "start"},
+		{"Single element", List[int]{1}, "start", "start1"},
+		{"Multiple elements", List[int]{1, 2, 3}, "", "123"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Fold(tt.list, tt.initial, func(acc string, item int) string { return acc + strconv.Itoa(item) })
+			assert.Equal(t, tt.want, got, "Fold() should return expected result")
+		})
+	}
+}
+
+func TestFoldIndexed(t *testing.T) {
+	got := FoldIndexed(List[int]{10, 20, 30}, 0, func(index int, acc int, item int) int { return acc + index*item })
+	assert.Equal(t, 80, got, "FoldIndexed() should pass element indexes")
+}
+
+func TestFoldRight(t *testing.T) {
+	tests := []struct {
+		name    string
+		list    List[int]
+		initial string
+		want    string
+	}{
+		{"Empty list", List[int]{}, "start", "start"},
+		{"Multiple elements", List[int]{1, 2, 3}, "", "321"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := FoldRight(tt.list, tt.initial, func(acc string, item int) string { return acc + strconv.Itoa(item) })
+			assert.Equal(t, tt.want, got, "FoldRight() should return expected result")
+		})
+	}
+}
+
+func TestFoldRightIndexed(t *testing.T) {
+	var indexes []int
+
+	FoldRightIndexed(List[string]{"a", "b", "c"}, "", func(index int, acc string, item string) string {
+		indexes = append(indexes, index)
+		return acc + item
+	})
+
+	assert.Equal(t, []int{2, 1, 0}, indexes, "FoldRightIndexed() should visit indexes from the end")
+}
+
+func TestReduce(t *testing.T) {
+	tests := []struct {
+		name    string
+		list    List[int]
+		want    int
+		wantErr error
+	}{
+		{"Empty list", List[int]{}, 0, ErrEmptyList},
+		{"Single element", List[int]{5}, 5, nil},
+		{"Multiple elements", List[int]{1, 2, 3, 4}, 10, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.list.Reduce(func(acc int, item int) int { return acc + item })
+			assert.ErrorIs(t, err, tt.wantErr, "Reduce() should return expected error")
+			assert.Equal(t, tt.want, got, "Reduce() should return expected result")
+		})
+	}
+}
+
+func TestReduceIndexed(t *testing.T) {
+	list := List[int]{5, 5, 5}
+
+	got, err := list.ReduceIndexed(func(index int, acc int, item int) int { return acc + index*item })
+
+	assert.NoError(t, err)
+	assert.Equal(t, 20, got, "ReduceIndexed() should start from the second index")
+}
+
+func TestScan(t *testing.T) {
+	tests := []struct {
+		name string
+		list List[int]
+		want List[int]
+	}{
+		{"Empty list", List[int]{}, List[int]{100}},
+		{"Running balance", List[int]{10, -20, 5}, List[int]{100, 110, 90, 95}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sum := func(acc int, item int) int { return acc + item }
+			assert.Equal(t, tt.want, Scan(tt.list, 100, sum), "Scan() should return expected result")
+			assert.Equal(t, tt.want, RunningFold(tt.list, 100, sum), "RunningFold() should match Scan()")
+		})
+	}
+}
+
+func TestScanIndexed(t *testing.T) {
+	got := ScanIndexed(List[string]{"a", "b"}, "", func(index int, acc string, item string) string {
+		return acc + strconv.Itoa(index) + item
+	})
+
+	assert.Equal(t, List[string]{"", "0a", "0a1b"}, got, "ScanIndexed() should return expected result")
+	assert.Equal(t, got, RunningFoldIndexed(List[string]{"a", "b"}, "", func(index int, acc string, item string) string {
+		return acc + strconv.Itoa(index) + item
+	}), "RunningFoldIndexed() should match ScanIndexed()")
+}
+
+func TestRunningReduce(t *testing.T) {
+	tests := []struct {
+		name string
+		list List[int]
+		want List[int]
+	}{
+		{"Empty list", List[int]{}, List[int]{}},
+		{"Single element", List[int]{3}, List[int]{3}},
+		{"Cumulative sum", List[int]{1, 2, 3, 4}, List[int]{1, 3, 6, 10}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.list.RunningReduce(func(acc int, item int) int { return acc + item })
+			assert.Equal(t, tt.want, got, "RunningReduce() should return expected result")
+		})
+	}
+}
+
+func TestRunningReduceIndexed(t *testing.T) {
+	list := List[int]{1, 1, 1}
+
+	got := list.RunningReduceIndexed(func(index int, acc int, item int) int { return acc + index*item })
+
+	assert.Equal(t, List[int]{1, 2, 4}, got, "RunningReduceIndexed() should return expected result")
+}
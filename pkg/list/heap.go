@@ -0,0 +1,46 @@
+package list
+
+import "github.com/zach-robinson-dev/kollections/pkg/comparator"
+
+type indexedItem[T any] struct {
+	index int
+	value T
+}
+
+func indexedOrder[T any](order comparator.Comparator[T]) comparator.Comparator[indexedItem[T]] {
+	return func(a indexedItem[T], b indexedItem[T]) int {
+		switch result := order(a.value, b.value); result {
+		case 0:
+			return a.index - b.index
+		default:
+			return result
+		}
+	}
+}
+
+type itemHeap[T any] struct {
+	compare comparator.Comparator[indexedItem[T]]
+	items   []indexedItem[T]
+}
+
+func (h *itemHeap[T]) Len() int {
+	return len(h.items)
+}
+
+func (h *itemHeap[T]) Less(i int, j int) bool {
+	return h.compare(h.items[i], h.items[j]) < 0
+}
+
+func (h *itemHeap[T]) Swap(i int, j int) {
+	h.items[i], h.items[j] = h.items[j], h.items[i]
+}
+
+func (h *itemHeap[T]) Push(item any) {
+	h.items = append(h.items, item.(indexedItem[T]))
+}
+
+func (h *itemHeap[T]) Pop() any {
+	last := h.items[len(h.items)-1]
+	h.items = h.items[:len(h.items)-1]
+	return last
+}
@@ -1,8 +1,6 @@
 package list
 
 import (
-	"reflect"
-
 	"github.com/zach-robinson-dev/kollections/pkg/comparator"
 )
 
@@ -19,13 +17,7 @@ func (l *List[T]) Filter(predicate PredicateFunc[T]) List[T] {
 }
 
 func (l *List[T]) Contains(t T) bool {
-	for _, element := range *l {
-		if reflect.DeepEqual(t, element) {
-			return true
-		}
-	}
-
-	return false
+	return l.IndexOf(t) >= 0
 }
 
 func (l *List[T]) RemoveAll(elements ...T) bool {
@@ -95,6 +87,16 @@ func (l *List[T]) MinWith(comparator comparator.Comparator[T]) T {
 	}
 }
 
+func (l *List[T]) MinWithE(comparator comparator.Comparator[T]) (T, error) {
+	switch result := l.MinWithOrNil(comparator); result {
+	case nil:
+		var zero T
+		return zero, ErrEmptyList
+	default:
+		return *result, nil
+	}
+}
+
 func (l *List[T]) MaxWithOrNil(comparator comparator.Comparator[T]) *T {
 	if len(*l) == 0 {
 		return nil
@@ -121,6 +123,16 @@ func (l *List[T]) MaxWith(comparator comparator.Comparator[T]) T {
 	}
 }
 
+func (l *List[T]) MaxWithE(comparator comparator.Comparator[T]) (T, error) {
+	switch result := l.MaxWithOrNil(comparator); result {
+	case nil:
+		var zero T
+		return zero, ErrEmptyList
+	default:
+		return *result, nil
+	}
+}
+
 func Map[T any, R any](list List[T], transform TransformFunc[T, R]) List[R] {
 	result := make(List[R], 0, len(list))
 
@@ -0,0 +1,62 @@
+package list
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+func DefaultJoinOptions[T any]() JoinOptions[T] {
+	return JoinOptions[T]{Separator: ", ", Truncated: "..."}
+}
+
+func (l *List[T]) JoinToString(options JoinOptions[T]) string {
+	var builder strings.Builder
+
+	_, _ = l.AppendTo(&builder, options)
+
+	return builder.String()
+}
+
+func (l *List[T]) AppendTo(writer io.Writer, options JoinOptions[T]) (int, error) {
+	transform := options.Transform
+	if transform == nil {
+		transform = func(item T) string { return fmt.Sprint(item) }
+	}
+
+	joinWriter := &countingWriter{writer: writer}
+	joinWriter.write(options.Prefix)
+
+	for index, element := range *l {
+		if index > 0 {
+			joinWriter.write(options.Separator)
+		}
+
+		if options.Limit > 0 && index >= options.Limit {
+			joinWriter.write(options.Truncated)
+			break
+		}
+
+		joinWriter.write(transform(element))
+	}
+
+	joinWriter.write(options.Postfix)
+
+	return joinWriter.written, joinWriter.err
+}
+
+type countingWriter struct {
+	writer  io.Writer
+	written int
+	err     error
+}
+
+func (w *countingWriter) write(value string) {
+	if w.err != nil || value == "" {
+		return
+	}
+
+	written, err := io.WriteString(w.writer, value)
+	w.written += written
+	w.err = err
+}
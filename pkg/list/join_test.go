@@ -0,0 +1,106 @@
+package list
+
+import (
+	"bytes"
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJoinToString(t *testing.T) {
+	tests := []struct {
+		name    string
+		list    List[int]
+		options JoinOptions[int]
+		want    string
+	}{
+		{"Empty list with defaults", List[int]{}, DefaultJoinOptions[int](), ""},
+		{"Defaults", List[int]{1, 2, 3}, DefaultJoinOptions[int](), "1, 2, 3"},
+		{"Zero options", List[int]{1, 2, 3}, JoinOptions[int]{}, "123"},
+		{
+			name:    "Prefix and postfix",
+			list:    List[int]{1, 2},
+			options: JoinOptions[int]{Separator: "|", Prefix: "[", Postfix: "]"},
+			want:    "[1|2]",
+		},
+		{
+			name:    "Prefix and postfix on empty list",
+			list:    List[int]{},
+			options: JoinOptions[int]{Separator: "|", Prefix: "[", Postfix: "]"},
+			want:    "[]",
+		},
+		{
+			name:    "Limit truncates",
+			list:    List[int]{1, 2, 3, 4, 5},
+			options: JoinOptions[int]{Separator: ", ", Prefix: "<", Postfix: ">", Limit: 2, Truncated: "..."},
+			want:    "<1, 2, ...>",
+		},
+		{
+			name:    "Limit equal to length does not truncate",
+			list:    List[int]{1, 2},
+			options: JoinOptions[int]{Separator: ", ", Limit: 2, Truncated: "..."},
+			want:    "1, 2",
+		},
+		{
+			name:    "Custom truncation marker",
+			list:    List[int]{1, 2, 3},
+			options: JoinOptions[int]{Separator: " ", Limit: 1, Truncated: "(+2 more)"},
+			want:    "1 (+2 more)",
+		},
+		{
+			name: "Transform",
+			list: List[int]{10, 255},
+			options: JoinOptions[int]{Separator: ",", Transform: func(item int) string {
+				return "0x" + strconv.FormatInt(int64(item), 16)
+			}},
+			want: "0xa,0xff",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.list.JoinToString(tt.options)
+			assert.Equal(t, tt.want, got, "JoinToString() should return expected result")
+		})
+	}
+}
+
+func TestAppendTo(t *testing.T) {
+	list := List[string]{"a", "b", "c"}
+
+	var buffer bytes.Buffer
+	buffer.WriteString("items: ")
+
+	written, err := list.AppendTo(&buffer, JoinOptions[string]{Separator: "; ", Postfix: "."})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "items: a; b; c.", buffer.String())
+	assert.Equal(t, len("a; b; c."), written)
+}
+
+type failingWriter struct {
+	remaining int
+}
+
+func (w *failingWriter) Write(p []byte) (int, error) {
+	if w.remaining < len(p) {
+		written := w.remaining
+		w.remaining = 0
+		return written, errors.New("write failed")
+	}
+
+	w.remaining -= len(p)
+
+	return len(p), nil
+}
+
+func TestAppendToStopsOnWriteError(t *testing.T) {
+	list := List[string]{"abc", "def"}
+
+	written, err := list.AppendTo(&failingWriter{remaining: 4}, JoinOptions[string]{Separator: ","})
+
+	assert.EqualError(t, err, "write failed")
+	assert.Equal(t, 4, written)
+}
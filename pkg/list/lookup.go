@@ -0,0 +1,108 @@
+package list
+
+import (
+	"github.com/zach-robinson-dev/kollections/pkg/comparator"
+	"github.com/zach-robinson-dev/kollections/pkg/util"
+)
+
+func (l *List[T]) First() (T, error) {
+	if len(*l) == 0 {
+		var zero T
+		return zero, ErrEmptyList
+	}
+
+	return (*l)[0], nil
+}
+
+func (l *List[T]) FirstOrNil() *T {
+	return pointerOrNil(l.First())
+}
+
+func (l *List[T]) Last() (T, error) {
+	if len(*l) == 0 {
+		var zero T
+		return zero, ErrEmptyList
+	}
+
+	return (*l)[len(*l)-1], nil
+}
+
+func (l *List[T]) LastOrNil() *T {
+	return pointerOrNil(l.Last())
+}
+
+func (l *List[T]) Single() (T, error) {
+	switch len(*l) {
+	case 0:
+		var zero T
+		return zero, ErrEmptyList
+	case 1:
+		return (*l)[0], nil
+	default:
+		var zero T
+		return zero, ErrMultipleElements
+	}
+}
+
+func (l *List[T]) Find(predicate PredicateFunc[T]) (T, error) {
+	return l.elementAt(l.IndexOfFirst(predicate))
+}
+
+func (l *List[T]) FindLast(predicate PredicateFunc[T]) (T, error) {
+	return l.elementAt(l.IndexOfLast(predicate))
+}
+
+func (l *List[T]) elementAt(index int) (T, error) {
+	if index < 0 {
+		var zero T
+		return zero, ErrNoSuchElement
+	}
+
+	return (*l)[index], nil
+}
+
+func pointerOrNil[T any](value T, err error) *T {
+	switch err {
+	case nil:
+		return &value
+	default:
+		return nil
+	}
+}
+
+func (l *List[T]) GetOptional(index int) util.Optional[T] {
+	return optionalOf(l.Get(index))
+}
+
+func (l *List[T]) FirstOptional() util.Optional[T] {
+	return optionalOf(l.First())
+}
+
+func (l *List[T]) LastOptional() util.Optional[T] {
+	return optionalOf(l.Last())
+}
+
+func (l *List[T]) FindOptional(predicate PredicateFunc[T]) util.Optional[T] {
+	return optionalOf(l.Find(predicate))
+}
+
+func (l *List[T]) FindLastOptional(predicate PredicateFunc[T]) util.Optional[T] {
+	return optionalOf(l.FindLast(predicate))
+}
+
+func (l *List[T]) MinWithOptional(comparator comparator.Comparator[T]) util.Optional[T] {
+	return optionalOf(l.MinWithE(comparator))
+}
+
+func (l *List[T]) MaxWithOptional(comparator comparator.Comparator[T]) util.Optional[T] {
+	return optionalOf(l.MaxWithE(comparator))
+}
+
+func optionalOf[T any](value T, err error) util.Optional[T] {
+	switch err {
+	case nil:
+		return util.OptionalOf(value)
+	default:
+		return util.EmptyOptional[T]()
+	}
+}
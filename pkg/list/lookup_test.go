@@ -0,0 +1,150 @@
+package list
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zach-robinson-dev/kollections/pkg/comparator"
+	"github.com/zach-robinson-dev/kollections/pkg/util"
+)
+
+func TestFirstAndLast(t *testing.T) {
+	tests := []struct {
+		name      string
+		list      List[int]
+		wantFirst int
+		wantLast  int
+		wantErr   error
+	}{
+		{"Empty list", List[int]{}, 0, 0, ErrEmptyList},
+		{"Single element", List[int]{4}, 4, 4, nil},
+		{"Multiple elements", List[int]{1, 2, 3}, 1, 3, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			first, err := tt.list.First()
+			assert.ErrorIs(t, err, tt.wantErr, "First() should return expected error")
+			assert.Equal(t, tt.wantFirst, first, "First() should return expected result")
+
+			last, err := tt.list.Last()
+			assert.ErrorIs(t, err, tt.wantErr, "Last() should return expected error")
+			assert.Equal(t, tt.wantLast, last, "Last() should return expected result")
+		})
+	}
+}
+
+func TestFirstAndLastOrNil(t *testing.T) {
+	list := List[int]{1, 2, 3}
+	empty := List[int]{}
+
+	assert.Equal(t, util.PointerTo(1), list.FirstOrNil())
+	assert.Equal(t, util.PointerTo(3), list.LastOrNil())
+	assert.Nil(t, empty.FirstOrNil())
+	assert.Nil(t, empty.LastOrNil())
+}
+
+func TestEmptyListErrorIsNoSuchElement(t *testing.T) {
+	empty := List[int]{}
+
+	_, err := empty.First()
+
+	assert.ErrorIs(t, err, ErrNoSuchElement, "empty list errors should match ErrNoSuchElement")
+}
+
+func TestSingle(t *testing.T) {
+	tests := []struct {
+		name    string
+		list    List[string]
+		want    string
+		wantErr error
+	}{
+		{"Empty list", List[string]{}, "", ErrNoSuchElement},
+		{"Single element", List[string]{"only"}, "only", nil},
+		{"Multiple elements", List[string]{"a", "b"}, "", ErrMultipleElements},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.list.Single()
+			assert.ErrorIs(t, err, tt.wantErr, "Single() should return expected error")
+			assert.Equal(t, tt.want, got, "Single() should return expected result")
+		})
+	}
+}
+
+func TestFind(t *testing.T) {
+	list := List[int]{1, 4, 3, 6, 5}
+	isEven := func(x int) bool { return x%2 == 0 }
+	isNegative := func(x int) bool { return x < 0 }
+
+	found, err := list.Find(isEven)
+	assert.NoError(t, err)
+	assert.Equal(t, 4, found)
+
+	found, err = list.FindLast(isEven)
+	assert.NoError(t, err)
+	assert.Equal(t, 6, found)
+
+	_, err = list.Find(isNegative)
+	assert.ErrorIs(t, err, ErrNoSuchElement)
+
+	_, err = list.FindLast(isNegative)
+	assert.ErrorIs(t, err, ErrNoSuchElement)
+}
+
+func TestMinAndMaxWithE(t *testing.T) {
+	tests := []struct {
+		name    string
+		list    List[int]
+		wantMin int
+		wantMax int
+		wantErr error
+	}{
+		{"Empty list", List[int]{}, 0, 0, ErrEmptyList},
+		{"Minimum is zero", List[int]{3, 0, 5}, 0, 5, nil},
+		{"Negative values", List[int]{-3, -1, -2}, -3, -1, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			minimum, err := tt.list.MinWithE(comparator.AscendingOrder[int]())
+			assert.ErrorIs(t, err, tt.wantErr, "MinWithE() should return expected error")
+			assert.Equal(t, tt.wantMin, minimum, "MinWithE() should return expected result")
+
+			maximum, err := tt.list.MaxWithE(comparator.AscendingOrder[int]())
+			assert.ErrorIs(t, err, tt.wantErr, "MaxWithE() should return expected error")
+			assert.Equal(t, tt.wantMax, maximum, "MaxWithE() should return expected result")
+		})
+	}
+}
+
+func TestOptionalLookups(t *testing.T) {
+	list := List[int]{0, 1, 2, 3}
+	empty := List[int]{}
+	isOdd := func(x int) bool { return x%2 != 0 }
+
+	assert.Equal(t, util.OptionalOf(2), list.GetOptional(2))
+	assert.Equal(t, util.EmptyOptional[int](), list.GetOptional(4))
+
+	assert.Equal(t, util.OptionalOf(0), list.FirstOptional(), "a zero first element should be present")
+	assert.Equal(t, util.OptionalOf(3), list.LastOptional())
+	assert.Equal(t, util.EmptyOptional[int](), empty.FirstOptional())
+	assert.Equal(t, util.EmptyOptional[int](), empty.LastOptional())
+
+	assert.Equal(t, util.OptionalOf(1), list.FindOptional(isOdd))
+	assert.Equal(t, util.OptionalOf(3), list.FindLastOptional(isOdd))
+	assert.Equal(t, util.EmptyOptional[int](), empty.FindOptional(isOdd))
+	assert.Equal(t, util.EmptyOptional[int](), empty.FindLastOptional(isOdd))
+}
+
+func TestMinAndMaxWithOptional(t *testing.T) {
+	list := List[int]{3, 0, 5}
+	empty := List[int]{}
+
+	assert.Equal(t, util.OptionalOf(0), list.MinWithOptional(comparator.AscendingOrder[int]()))
+	assert.Equal(t, util.OptionalOf(5), list.MaxWithOptional(comparator.AscendingOrder[int]()))
+	assert.Equal(t, util.EmptyOptional[int](), empty.MinWithOptional(comparator.AscendingOrder[int]()))
+	assert.Equal(t, util.EmptyOptional[int](), empty.MaxWithOptional(comparator.AscendingOrder[int]()))
+}
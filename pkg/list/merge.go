@@ -0,0 +1,97 @@
+package list
+
+import (
+	"container/heap"
+
+	"github.com/zach-robinson-dev/kollections/pkg/comparator"
+)
+
+func MergeSorted[T any](comparator comparator.Comparator[T], lists ...List[T]) List[T] {
+	return collect(MergeSortedIterator(comparator, lists...), totalLength(lists))
+}
+
+func MergeSortedDistinct[T any](comparator comparator.Comparator[T], lists ...List[T]) List[T] {
+	return collect(MergeSortedDistinctIterator(comparator, lists...), totalLength(lists))
+}
+
+func MergeSortedIterator[T any](comparator comparator.Comparator[T], lists ...List[T]) Iterator[T] {
+	return newMergeIterator(comparator, false, lists)
+}
+
+func MergeSortedDistinctIterator[T any](comparator comparator.Comparator[T], lists ...List[T]) Iterator[T] {
+	return newMergeIterator(comparator, true, lists)
+}
+
+type mergeIterator[T any] struct {
+	comparator comparator.Comparator[T]
+	distinct   bool
+	lists      []List[T]
+	positions  []int
+	heads      *itemHeap[T]
+	last       *T
+}
+
+func newMergeIterator[T any](comparator comparator.Comparator[T], distinct bool, lists []List[T]) *mergeIterator[T] {
+	heads := &itemHeap[T]{compare: indexedOrder(comparator), items: make([]indexedItem[T], 0, len(lists))}
+
+	for index, list := range lists {
+		if len(list) > 0 {
+			heads.items = append(heads.items, indexedItem[T]{index: index, value: list[0]})
+		}
+	}
+
+	heap.Init(heads)
+
+	return &mergeIterator[T]{
+		comparator: comparator,
+		distinct:   distinct,
+		lists:      lists,
+		positions:  make([]int, len(lists)),
+		heads:      heads,
+	}
+}
+
+func (it *mergeIterator[T]) Next() (T, bool) {
+	for it.heads.Len() > 0 {
+		head := it.heads.items[0]
+
+		switch it.positions[head.index]++; {
+		case it.positions[head.index] < len(it.lists[head.index]):
+			it.heads.items[0].value = it.lists[head.index][it.positions[head.index]]
+			heap.Fix(it.heads, 0)
+		default:
+			heap.Pop(it.heads)
+		}
+
+		if it.distinct && it.last != nil && it.comparator(*it.last, head.value) == 0 {
+			continue
+		}
+
+		it.last = &head.value
+
+		return head.value, true
+	}
+
+	var zero T
+	return zero, false
+}
+
+func collect[T any](iterator Iterator[T], capacity int) List[T] {
+	result := make(List[T], 0, capacity)
+
+	for element, ok := iterator.Next(); ok; element, ok = iterator.Next() {
+		result = append(result, element)
+	}
+
+	return result
+}
+
+func totalLength[T any](lists []List[T]) int {
+	length := 0
+
+	for _, list := range lists {
+		length += len(list)
+	}
+
+	return length
+}
@@ -0,0 +1,99 @@
+package list
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zach-robinson-dev/kollections/pkg/comparator"
+)
+
+func TestMergeSorted(t *testing.T) {
+	tests := []struct {
+		name  string
+		lists []List[int]
+		want  List[int]
+	}{
+		{"No lists", nil, List[int]{}},
+		{"Only empty lists", []List[int]{{}, {}}, List[int]{}},
+		{"Single list", []List[int]{{1, 2, 3}}, List[int]{1, 2, 3}},
+		{"Interleaved lists", []List[int]{{1, 4, 7}, {2, 5, 8}, {3, 6, 9}}, List[int]{1, 2, 3, 4, 5, 6, 7, 8, 9}},
+		{"Uneven lists", []List[int]{{5}, {}, {1, 2, 3, 10}}, List[int]{1, 2, 3, 5, 10}},
+		{"Duplicates kept", []List[int]{{1, 2, 2}, {2, 3}}, List[int]{1, 2, 2, 2, 3}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := MergeSorted(comparator.AscendingOrder[int](), tt.lists...)
+			assert.Equal(t, tt.want, got, "MergeSorted() should return expected result")
+		})
+	}
+}
+
+func TestMergeSortedIsStable(t *testing.T) {
+	type shardResult struct {
+		shard string
+		score int
+	}
+
+	byScore := comparator.AscendingOrderBy(func(r shardResult) int { return r.score })
+
+	got := MergeSorted(byScore,
+		List[shardResult]{{"a", 1}, {"a", 2}, {"a", 2}},
+		List[shardResult]{{"b", 2}, {"b", 3}},
+		List[shardResult]{{"c", 1}, {"c", 2}},
+	)
+
+	want := List[shardResult]{{"a", 1}, {"c", 1}, {"a", 2}, {"a", 2}, {"b", 2}, {"c", 2}, {"b", 3}}
+	assert.Equal(t, want, got, "MergeSorted() should keep earlier lists first for equal elements")
+}
+
+func TestMergeSortedDistinct(t *testing.T) {
+	tests := []struct {
+		name  string
+		lists []List[int]
+		want  List[int]
+	}{
+		{"No lists", nil, List[int]{}},
+		{"Duplicates across lists", []List[int]{{1, 3, 5}, {1, 2, 3}}, List[int]{1, 2, 3, 5}},
+		{"Duplicates within a list", []List[int]{{1, 1, 1, 2}, {2, 2}}, List[int]{1, 2}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := MergeSortedDistinct(comparator.AscendingOrder[int](), tt.lists...)
+			assert.Equal(t, tt.want, got, "MergeSortedDistinct() should return expected result")
+		})
+	}
+}
+
+func TestMergeSortedIterator(t *testing.T) {
+	iterator := MergeSortedIterator(comparator.DescendingOrder[int](), List[int]{9, 4}, List[int]{7, 5, 1})
+
+	var got []int
+
+	for len(got) < 3 {
+		element, ok := iterator.Next()
+		assert.True(t, ok)
+		got = append(got, element)
+	}
+
+	assert.Equal(t, []int{9, 7, 5}, got, "MergeSortedIterator() should yield elements on demand")
+
+	next, ok := iterator.Next()
+	assert.True(t, ok)
+	assert.Equal(t, 4, next)
+
+	next, ok = iterator.Next()
+	assert.True(t, ok)
+	assert.Equal(t, 1, next)
+
+	_, ok = iterator.Next()
+	assert.False(t, ok, "MergeSortedIterator() should report exhaustion")
+}
+
+func TestMergeSortedDistinctIterator(t *testing.T) {
+	iterator := MergeSortedDistinctIterator(comparator.AscendingOrder[string](), List[string]{"a", "b"}, List[string]{"a", "c"})
+
+	assert.Equal(t, List[string]{"a", "b", "c"}, collect(iterator, 0))
+}
@@ -0,0 +1,29 @@
+package list
+
+func Sum[N Number](list List[N]) N {
+	return SumBy(list, func(item N) N { return item })
+}
+
+func SumBy[T any, N Number](list List[T], selector TransformFunc[T, N]) N {
+	var sum N
+
+	for _, element := range list {
+		sum += selector(element)
+	}
+
+	return sum
+}
+
+func Average[N Number](list List[N]) (float64, error) {
+	if len(list) == 0 {
+		return 0, ErrEmptyList
+	}
+
+	sum := 0.0
+
+	for _, element := range list {
+		sum += float64(element)
+	}
+
+	return sum / float64(len(list)), nil
+}
@@ -0,0 +1,47 @@
+package list
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSum(t *testing.T) {
+	assert.Equal(t, 0, Sum(List[int]{}), "Sum() of an empty list should be zero")
+	assert.Equal(t, 10, Sum(List[int]{1, 2, 3, 4}), "Sum() should add integers")
+	assert.InDelta(t, 4.0, Sum(List[float64]{1.5, 2.5}), 1e-9, "Sum() should add floats")
+}
+
+func TestSumBy(t *testing.T) {
+	type order struct {
+		id     string
+		amount int
+	}
+
+	orders := List[order]{{"a", 10}, {"b", 25}, {"c", 5}}
+
+	got := SumBy(orders, func(o order) int { return o.amount })
+
+	assert.Equal(t, 40, got, "SumBy() should add selected values")
+}
+
+func TestAverage(t *testing.T) {
+	tests := []struct {
+		name    string
+		list    List[int]
+		want    float64
+		wantErr error
+	}{
+		{"Empty list", List[int]{}, 0, ErrEmptyList},
+		{"Single element", List[int]{4}, 4, nil},
+		{"Fractional average", List[int]{1, 2}, 1.5, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Average(tt.list)
+			assert.ErrorIs(t, err, tt.wantErr, "Average() should return expected error")
+			assert.InDelta(t, tt.want, got, 1e-9, "Average() should return expected result")
+		})
+	}
+}
@@ -0,0 +1,151 @@
+package list
+
+import (
+	"reflect"
+	"slices"
+)
+
+func (l *List[T]) IndexOf(item T) int {
+	return l.IndexOfFirst(func(element T) bool { return reflect.DeepEqual(item, element) })
+}
+
+func (l *List[T]) LastIndexOf(item T) int {
+	return l.IndexOfLast(func(element T) bool { return reflect.DeepEqual(item, element) })
+}
+
+func (l *List[T]) IndexOfFirst(predicate PredicateFunc[T]) int {
+	for index, element := range *l {
+		if predicate(element) {
+			return index
+		}
+	}
+
+	return -1
+}
+
+func (l *List[T]) IndexOfLast(predicate PredicateFunc[T]) int {
+	for index := len(*l) - 1; index >= 0; index-- {
+		if predicate((*l)[index]) {
+			return index
+		}
+	}
+
+	return -1
+}
+
+func (l *List[T]) Get(index int) (T, error) {
+	if err := l.checkIndex(index); err != nil {
+		var zero T
+		return zero, err
+	}
+
+	return (*l)[index], nil
+}
+
+func (l *List[T]) GetOrNil(index int) *T {
+	return pointerOrNil(l.Get(index))
+}
+
+func (l *List[T]) Insert(index int, items ...T) error {
+	if index < 0 || index > len(*l) {
+		return indexOutOfBounds(index, len(*l))
+	}
+
+	*l = slices.Insert(*l, index, items...)
+
+	return nil
+}
+
+func (l *List[T]) RemoveAt(index int) (T, error) {
+	removed, err := l.Get(index)
+	if err != nil {
+		return removed, err
+	}
+
+	*l = slices.Delete(*l, index, index+1)
+
+	return removed, nil
+}
+
+func (l *List[T]) Set(index int, item T) (T, error) {
+	previous, err := l.Get(index)
+	if err != nil {
+		return previous, err
+	}
+
+	(*l)[index] = item
+
+	return previous, nil
+}
+
+func (l *List[T]) Swap(i int, j int) error {
+	if err := l.checkIndex(i); err != nil {
+		return err
+	}
+
+	if err := l.checkIndex(j); err != nil {
+		return err
+	}
+
+	(*l)[i], (*l)[j] = (*l)[j], (*l)[i]
+
+	return nil
+}
+
+func (l *List[T]) Rotate(distance int) {
+	if len(*l) == 0 {
+		return
+	}
+
+	split := len(*l) - ((distance%len(*l))+len(*l))%len(*l)
+
+	slices.Reverse((*l)[:split])
+	slices.Reverse((*l)[split:])
+	slices.Reverse(*l)
+}
+
+func (l *List[T]) Reverse() {
+	slices.Reverse(*l)
+}
+
+func (l *List[T]) Fill(value T) {
+	for index := range *l {
+		(*l)[index] = value
+	}
+}
+
+func (l *List[T]) FilterIndexed(predicate IndexedPredicateFunc[T]) List[T] {
+	result := make(List[T], 0, len(*l))
+
+	for index, element := range *l {
+		if predicate(index, element) {
+			result = append(result, element)
+		}
+	}
+
+	return result
+}
+
+func (l *List[T]) ForEachIndexed(action IndexedActionFunc[T]) {
+	for index, element := range *l {
+		action(index, element)
+	}
+}
+
+func MapIndexed[T any, R any](list List[T], transform IndexedTransformFunc[T, R]) List[R] {
+	result := make(List[R], 0, len(list))
+
+	for index, element := range list {
+		result = append(result, transform(index, element))
+	}
+
+	return result
+}
+
+func (l *List[T]) checkIndex(index int) error {
+	if index < 0 || index >= len(*l) {
+		return indexOutOfBounds(index, len(*l))
+	}
+
+	return nil
+}
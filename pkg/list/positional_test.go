@@ -0,0 +1,192 @@
+package list
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zach-robinson-dev/kollections/pkg/util"
+)
+
+func TestIndexOf(t *testing.T) {
+	tests := []struct {
+		name      string
+		list      List[int]
+		item      int
+		wantFirst int
+		wantLast  int
+	}{
+		{"Empty list", List[int]{}, 1, -1, -1},
+		{"Missing element", List[int]{1, 2}, 3, -1, -1},
+		{"Single occurrence", List[int]{1, 2, 3}, 2, 1, 1},
+		{"Multiple occurrences", List[int]{2, 1, 2, 1}, 1, 1, 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.wantFirst, tt.list.IndexOf(tt.item), "IndexOf() should return expected index")
+			assert.Equal(t, tt.wantLast, tt.list.LastIndexOf(tt.item), "LastIndexOf() should return expected index")
+		})
+	}
+}
+
+func TestIndexOfFirstAndLast(t *testing.T) {
+	list := List[int]{1, 4, 3, 6, 5}
+	isEven := func(x int) bool { return x%2 == 0 }
+
+	assert.Equal(t, 1, list.IndexOfFirst(isEven))
+	assert.Equal(t, 3, list.IndexOfLast(isEven))
+	assert.Equal(t, -1, list.IndexOfFirst(func(x int) bool { return x > 10 }))
+	assert.Equal(t, -1, list.IndexOfLast(func(x int) bool { return x > 10 }))
+}
+
+func TestGet(t *testing.T) {
+	list := List[string]{"a", "b"}
+
+	element, err := list.Get(1)
+	assert.NoError(t, err)
+	assert.Equal(t, "b", element)
+
+	_, err = list.Get(2)
+	assert.ErrorIs(t, err, ErrIndexOutOfBounds)
+
+	var boundsErr *IndexOutOfBoundsError
+	assert.True(t, errors.As(err, &boundsErr))
+	assert.Equal(t, &IndexOutOfBoundsError{Index: 2, Length: 2}, boundsErr)
+
+	_, err = list.Get(-1)
+	assert.ErrorIs(t, err, ErrIndexOutOfBounds)
+}
+
+func TestGetOrNil(t *testing.T) {
+	list := List[int]{7, 8}
+
+	assert.Equal(t, util.PointerTo(8), list.GetOrNil(1))
+	assert.Nil(t, list.GetOrNil(2))
+	assert.Nil(t, list.GetOrNil(-1))
+}
+
+func TestInsert(t *testing.T) {
+	tests := []struct {
+		name    string
+		list    List[int]
+		index   int
+		items   []int
+		want    List[int]
+		wantErr error
+	}{
+		{"Insert into empty list", List[int]{}, 0, []int{1, 2}, List[int]{1, 2}, nil},
+		{"Insert at start", List[int]{3}, 0, []int{1, 2}, List[int]{1, 2, 3}, nil},
+		{"Insert in middle", List[int]{1, 4}, 1, []int{2, 3}, List[int]{1, 2, 3, 4}, nil},
+		{"Insert at end", List[int]{1}, 1, []int{2}, List[int]{1, 2}, nil},
+		{"Index past end", List[int]{1}, 2, []int{2}, List[int]{1}, ErrIndexOutOfBounds},
+		{"Negative index", List[int]{1}, -1, []int{2}, List[int]{1}, ErrIndexOutOfBounds},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.list.Insert(tt.index, tt.items...)
+			assert.ErrorIs(t, err, tt.wantErr, "Insert() should return expected error")
+			assert.Equal(t, tt.want, tt.list, "Insert() should modify list as expected")
+		})
+	}
+}
+
+func TestRemoveAt(t *testing.T) {
+	list := List[int]{1, 2, 3}
+
+	removed, err := list.RemoveAt(1)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, removed)
+	assert.Equal(t, List[int]{1, 3}, list)
+
+	_, err = list.RemoveAt(2)
+	assert.ErrorIs(t, err, ErrIndexOutOfBounds)
+	assert.Equal(t, List[int]{1, 3}, list)
+}
+
+func TestSet(t *testing.T) {
+	list := List[int]{1, 2, 3}
+
+	previous, err := list.Set(0, 10)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, previous)
+	assert.Equal(t, List[int]{10, 2, 3}, list)
+
+	_, err = list.Set(3, 10)
+	assert.ErrorIs(t, err, ErrIndexOutOfBounds)
+}
+
+func TestSwap(t *testing.T) {
+	list := List[int]{1, 2, 3}
+
+	assert.NoError(t, list.Swap(0, 2))
+	assert.Equal(t, List[int]{3, 2, 1}, list)
+
+	assert.ErrorIs(t, list.Swap(0, 3), ErrIndexOutOfBounds)
+	assert.ErrorIs(t, list.Swap(-1, 0), ErrIndexOutOfBounds)
+	assert.Equal(t, List[int]{3, 2, 1}, list)
+}
+
+func TestRotate(t *testing.T) {
+	tests := []struct {
+		name     string
+		list     List[int]
+		distance int
+		want     List[int]
+	}{
+		{"Empty list", List[int]{}, 3, List[int]{}},
+		{"Zero distance", List[int]{1, 2, 3}, 0, List[int]{1, 2, 3}},
+		{"Rotate right", List[int]{1, 2, 3, 4, 5}, 2, List[int]{4, 5, 1, 2, 3}},
+		{"Rotate left", List[int]{1, 2, 3, 4, 5}, -1, List[int]{2, 3, 4, 5, 1}},
+		{"Distance larger than length", List[int]{1, 2, 3}, 7, List[int]{3, 1, 2}},
+		{"Full rotation", List[int]{1, 2, 3}, -3, List[int]{1, 2, 3}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.list.Rotate(tt.distance)
+			assert.Equal(t, tt.want, tt.list, "Rotate() should modify list as expected")
+		})
+	}
+}
+
+func TestReverse(t *testing.T) {
+	list := List[int]{1, 2, 3}
+	list.Reverse()
+	assert.Equal(t, List[int]{3, 2, 1}, list)
+
+	empty := List[int]{}
+	empty.Reverse()
+	assert.Equal(t, List[int]{}, empty)
+}
+
+func TestFill(t *testing.T) {
+	list := List[int]{1, 2, 3}
+	list.Fill(0)
+	assert.Equal(t, List[int]{0, 0, 0}, list)
+}
+
+func TestFilterIndexed(t *testing.T) {
+	list := List[string]{"a", "b", "c", "d"}
+
+	got := list.FilterIndexed(func(index int, item string) bool { return index%2 == 0 })
+
+	assert.Equal(t, List[string]{"a", "c"}, got, "FilterIndexed() should return expected result")
+}
+
+func TestMapIndexed(t *testing.T) {
+	got := MapIndexed(List[string]{"a", "b"}, func(index int, item string) string { return item + string(rune('0'+index)) })
+
+	assert.Equal(t, List[string]{"a0", "b1"}, got, "MapIndexed() should return expected result")
+}
+
+func TestForEachIndexed(t *testing.T) {
+	list := List[int]{5, 6}
+
+	var visited []int
+	list.ForEachIndexed(func(index int, item int) { visited = append(visited, index, item) })
+
+	assert.Equal(t, []int{0, 5, 1, 6}, visited)
+}
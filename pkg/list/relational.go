@@ -0,0 +1,123 @@
+package list
+
+import "github.com/zach-robinson-dev/kollections/pkg/util"
+
+func InnerJoin[A any, B any, K comparable, R any](
+	left List[A],
+	right List[B],
+	leftKey TransformFunc[A, K],
+	rightKey TransformFunc[B, K],
+	result CombineFunc[A, B, R],
+) List[R] {
+	index := indexBy(right, rightKey)
+	joined := make(List[R], 0, len(left))
+
+	for _, leftElement := range left {
+		for _, rightElement := range index[leftKey(leftElement)] {
+			joined = append(joined, result(leftElement, rightElement))
+		}
+	}
+
+	return joined
+}
+
+func LeftJoin[A any, B any, K comparable, R any](
+	left List[A],
+	right List[B],
+	leftKey TransformFunc[A, K],
+	rightKey TransformFunc[B, K],
+	result CombineFunc[A, util.Optional[B], R],
+) List[R] {
+	index := indexBy(right, rightKey)
+	joined := make(List[R], 0, len(left))
+
+	for _, leftElement := range left {
+		joined = appendMatches(joined, leftElement, index[leftKey(leftElement)], result)
+	}
+
+	return joined
+}
+
+func FullOuterJoin[A any, B any, K comparable, R any](
+	left List[A],
+	right List[B],
+	leftKey TransformFunc[A, K],
+	rightKey TransformFunc[B, K],
+	result CombineFunc[util.Optional[A], util.Optional[B], R],
+) List[R] {
+	index := indexBy(right, rightKey)
+	matchedKeys := make(map[K]struct{}, len(index))
+	joined := make(List[R], 0, len(left)+len(right))
+
+	for _, leftElement := range left {
+		key := leftKey(leftElement)
+
+		if _, isPresent := index[key]; isPresent {
+			matchedKeys[key] = struct{}{}
+		}
+
+		joined = appendMatches(joined, util.OptionalOf(leftElement), index[key], result)
+	}
+
+	for _, rightElement := range right {
+		if _, isMatched := matchedKeys[rightKey(rightElement)]; !isMatched {
+			joined = append(joined, result(util.EmptyOptional[A](), util.OptionalOf(rightElement)))
+		}
+	}
+
+	return joined
+}
+
+func GroupJoin[A any, B any, K comparable, R any](
+	left List[A],
+	right List[B],
+	leftKey TransformFunc[A, K],
+	rightKey TransformFunc[B, K],
+	result CombineFunc[A, List[B], R],
+) List[R] {
+	index := indexBy(right, rightKey)
+
+	return Map(left, func(leftElement A) R {
+		return result(leftElement, append(List[B]{}, index[leftKey(leftElement)]...))
+	})
+}
+
+func SemiJoin[A any, B any, K comparable](left List[A], right List[B], leftKey TransformFunc[A, K], rightKey TransformFunc[B, K]) List[A] {
+	return filterByMatch(left, right, leftKey, rightKey, true)
+}
+
+func AntiJoin[A any, B any, K comparable](left List[A], right List[B], leftKey TransformFunc[A, K], rightKey TransformFunc[B, K]) List[A] {
+	return filterByMatch(left, right, leftKey, rightKey, false)
+}
+
+func filterByMatch[A any, B any, K comparable](left List[A], right List[B], leftKey TransformFunc[A, K], rightKey TransformFunc[B, K], keepMatched bool) List[A] {
+	index := indexBy(right, rightKey)
+
+	return left.Filter(func(item A) bool {
+		_, isMatched := index[leftKey(item)]
+		return isMatched == keepMatched
+	})
+}
+
+func appendMatches[A any, B any, R any](joined List[R], leftElement A, matches List[B], result CombineFunc[A, util.Optional[B], R]) List[R] {
+	if len(matches) == 0 {
+		return append(joined, result(leftElement, util.EmptyOptional[B]()))
+	}
+
+	for _, rightElement := range matches {
+		joined = append(joined, result(leftElement, util.OptionalOf(rightElement)))
+	}
+
+	return joined
+}
+
+func indexBy[T any, K comparable](list List[T], keySelector TransformFunc[T, K]) map[K]List[T] {
+	index := make(map[K]List[T], len(list))
+
+	for _, element := range list {
+		key := keySelector(element)
+		index[key] = append(index[key], element)
+	}
+
+	return index
+}
@@ -0,0 +1,93 @@
+package list
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zach-robinson-dev/kollections/pkg/util"
+)
+
+type customer struct {
+	id   int
+	name string
+}
+
+type purchase struct {
+	customerID int
+	item       string
+}
+
+var (
+	customers = List[customer]{{1, "ann"}, {2, "bob"}, {3, "cid"}}
+	purchases = List[purchase]{{2, "lamp"}, {1, "desk"}, {4, "sofa"}, {2, "rug"}}
+
+	customerID = func(c customer) int { return c.id }
+	purchaser  = func(p purchase) int { return p.customerID }
+)
+
+func describe(c util.Optional[customer], p util.Optional[purchase]) string {
+	return util.MapOptional(c, func(c customer) string { return c.name }).OrElse("?") +
+		":" +
+		util.MapOptional(p, func(p purchase) string { return p.item }).OrElse("-")
+}
+
+func TestInnerJoin(t *testing.T) {
+	got := InnerJoin(customers, purchases, customerID, purchaser, func(c customer, p purchase) string {
+		return describe(util.OptionalOf(c), util.OptionalOf(p))
+	})
+
+	assert.Equal(t, List[string]{"ann:desk", "bob:lamp", "bob:rug"}, got)
+}
+
+func TestInnerJoinWithEmptyInputs(t *testing.T) {
+	combine := func(c customer, p purchase) string { return c.name + p.item }
+
+	assert.Equal(t, List[string]{}, InnerJoin(List[customer]{}, purchases, customerID, purchaser, combine))
+	assert.Equal(t, List[string]{}, InnerJoin(customers, List[purchase]{}, customerID, purchaser, combine))
+}
+
+func TestLeftJoin(t *testing.T) {
+	got := LeftJoin(customers, purchases, customerID, purchaser, func(c customer, p util.Optional[purchase]) string {
+		return describe(util.OptionalOf(c), p)
+	})
+
+	assert.Equal(t, List[string]{"ann:desk", "bob:lamp", "bob:rug", "cid:-"}, got)
+}
+
+func TestFullOuterJoin(t *testing.T) {
+	got := FullOuterJoin(customers, purchases, customerID, purchaser, describe)
+
+	assert.Equal(t, List[string]{"ann:desk", "bob:lamp", "bob:rug", "cid:-", "?:sofa"}, got)
+}
+
+func TestGroupJoin(t *testing.T) {
+	got := GroupJoin(customers, purchases, customerID, purchaser, func(c customer, matches List[purchase]) string {
+		return c.name + "=" + matches.JoinToString(JoinOptions[purchase]{
+			Separator: "+",
+			Transform: func(p purchase) string { return p.item },
+		})
+	})
+
+	assert.Equal(t, List[string]{"ann=desk", "bob=lamp+rug", "cid="}, got)
+}
+
+func TestGroupJoinGroupsAreIndependent(t *testing.T) {
+	left := List[int]{1, 1}
+	right := List[int]{1}
+	identity := func(x int) int { return x }
+
+	groups := GroupJoin(left, right, identity, identity, func(_ int, matches List[int]) List[int] { return matches })
+	groups[0][0] = 100
+
+	assert.Equal(t, List[List[int]]{{100}, {1}}, groups)
+	assert.Equal(t, List[int]{1}, right)
+}
+
+func TestSemiJoinAndAntiJoin(t *testing.T) {
+	assert.Equal(t, List[customer]{{1, "ann"}, {2, "bob"}}, SemiJoin(customers, purchases, customerID, purchaser))
+	assert.Equal(t, List[customer]{{3, "cid"}}, AntiJoin(customers, purchases, customerID, purchaser))
+
+	assert.Equal(t, List[customer]{}, SemiJoin(customers, List[purchase]{}, customerID, purchaser))
+	assert.Equal(t, customers, AntiJoin(customers, List[purchase]{}, customerID, purchaser))
+}
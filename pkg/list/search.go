@@ -0,0 +1,102 @@
+package list
+
+import (
+	"slices"
+
+	"github.com/zach-robinson-dev/kollections/pkg/comparator"
+)
+
+func (l *List[T]) BinarySearch(target T, comparator comparator.Comparator[T]) (int, bool) {
+	return slices.BinarySearchFunc(*l, target, comparator)
+}
+
+func BinarySearchBy[T any, K any](list List[T], key K, selector comparator.Selector[T, K], comparator comparator.Comparator[K]) (int, bool) {
+	return slices.BinarySearchFunc(list, key, func(element T, key K) int {
+		return comparator(selector(element), key)
+	})
+}
+
+func (l *List[T]) LowerBound(target T, comparator comparator.Comparator[T]) int {
+	return l.partitionPoint(func(element T) bool { return comparator(element, target) < 0 })
+}
+
+func (l *List[T]) UpperBound(target T, comparator comparator.Comparator[T]) int {
+	return l.partitionPoint(func(element T) bool { return comparator(element, target) <= 0 })
+}
+
+func (l *List[T]) InsertSorted(item T, comparator comparator.Comparator[T]) int {
+	index := l.UpperBound(item, comparator)
+	*l = slices.Insert(*l, index, item)
+	return index
+}
+
+func (l *List[T]) partitionPoint(isBefore PredicateFunc[T]) int {
+	low, high := 0, len(*l)
+
+	for low < high {
+		middle := int(uint(low+high) >> 1)
+
+		switch isBefore((*l)[middle]) {
+		case true:
+			low = middle + 1
+		default:
+			high = middle
+		}
+	}
+
+	return low
+}
+
+func NewSortedList[T any](comparator comparator.Comparator[T], items ...T) *SortedList[T] {
+	elements := slices.Clone(List[T](items))
+	slices.SortStableFunc(elements, comparator)
+
+	return &SortedList[T]{comparator: comparator, elements: elements}
+}
+
+func (s *SortedList[T]) Add(items ...T) {
+	for _, item := range items {
+		s.elements.InsertSorted(item, s.comparator)
+	}
+}
+
+func (s *SortedList[T]) Remove(item T) bool {
+	index := s.IndexOf(item)
+
+	if index >= 0 {
+		s.elements = slices.Delete(s.elements, index, index+1)
+	}
+
+	return index >= 0
+}
+
+func (s *SortedList[T]) Contains(item T) bool {
+	return s.IndexOf(item) >= 0
+}
+
+// IndexOf finds the range of elements the comparator considers equal to item and returns the
+// first one that is also equal under List.IndexOf, so items that only share a sort key are not
+// mistaken for each other.
+func (s *SortedList[T]) IndexOf(item T) int {
+	lower, upper := s.elements.LowerBound(item, s.comparator), s.elements.UpperBound(item, s.comparator)
+	candidates := s.elements[lower:upper]
+
+	switch index := candidates.IndexOf(item); {
+	case index >= 0:
+		return lower + index
+	default:
+		return -1
+	}
+}
+
+func (s *SortedList[T]) Get(index int) (T, error) {
+	return s.elements.Get(index)
+}
+
+func (s *SortedList[T]) Len() int {
+	return len(s.elements)
+}
+
+func (s *SortedList[T]) ToList() List[T] {
+	return slices.Clone(s.elements)
+}
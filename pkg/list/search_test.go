@@ -0,0 +1,143 @@
+package list
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zach-robinson-dev/kollections/pkg/comparator"
+)
+
+func TestBinarySearch(t *testing.T) {
+	tests := []struct {
+		name      string
+		list      List[int]
+		target    int
+		wantIndex int
+		wantFound bool
+	}{
+		{"Empty list", List[int]{}, 1, 0, false},
+		{"Found", List[int]{1, 3, 5, 7}, 5, 2, true},
+		{"First of duplicates", List[int]{1, 3, 3, 3, 7}, 3, 1, true},
+		{"Missing in middle", List[int]{1, 3, 5, 7}, 4, 2, false},
+		{"Missing before start", List[int]{1, 3}, 0, 0, false},
+		{"Missing after end", List[int]{1, 3}, 9, 2, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			index, found := tt.list.BinarySearch(tt.target, comparator.AscendingOrder[int]())
+			assert.Equal(t, tt.wantIndex, index, "BinarySearch() should return expected index")
+			assert.Equal(t, tt.wantFound, found, "BinarySearch() should return expected found flag")
+		})
+	}
+}
+
+func TestBinarySearchBy(t *testing.T) {
+	type country struct {
+		code string
+		name string
+	}
+
+	countries := List[country]{{"CA", "Canada"}, {"DE", "Germany"}, {"FR", "France"}, {"US", "United States"}}
+	selector := func(c country) string { return c.code }
+
+	index, found := BinarySearchBy(countries, "FR", selector, comparator.AscendingOrder[string]())
+	assert.True(t, found)
+	assert.Equal(t, 2, index)
+
+	index, found = BinarySearchBy(countries, "GB", selector, comparator.AscendingOrder[string]())
+	assert.False(t, found)
+	assert.Equal(t, 3, index)
+}
+
+func TestLowerAndUpperBound(t *testing.T) {
+	list := List[int]{1, 2, 2, 2, 5}
+
+	tests := []struct {
+		name      string
+		target    int
+		wantLower int
+		wantUpper int
+	}{
+		{"Before all", 0, 0, 0},
+		{"Duplicated value", 2, 1, 4},
+		{"Missing value", 3, 4, 4},
+		{"After all", 6, 5, 5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.wantLower, list.LowerBound(tt.target, comparator.AscendingOrder[int]()), "LowerBound() should return expected index")
+			assert.Equal(t, tt.wantUpper, list.UpperBound(tt.target, comparator.AscendingOrder[int]()), "UpperBound() should return expected index")
+		})
+	}
+}
+
+func TestInsertSorted(t *testing.T) {
+	type entry struct {
+		key   int
+		label string
+	}
+
+	byKey := comparator.AscendingOrderBy(func(e entry) int { return e.key })
+	list := List[entry]{{1, "a"}, {3, "b"}}
+
+	assert.Equal(t, 1, list.InsertSorted(entry{2, "c"}, byKey))
+	assert.Equal(t, 2, list.InsertSorted(entry{2, "d"}, byKey), "InsertSorted() should insert after equal elements")
+	assert.Equal(t, 0, list.InsertSorted(entry{0, "e"}, byKey))
+	assert.Equal(t, 5, list.InsertSorted(entry{9, "f"}, byKey))
+
+	assert.Equal(t, List[entry]{{0, "e"}, {1, "a"}, {2, "c"}, {2, "d"}, {3, "b"}, {9, "f"}}, list)
+}
+
+func TestSortedList(t *testing.T) {
+	sorted := NewSortedList(comparator.AscendingOrder[int](), 5, 1, 3)
+
+	sorted.Add(4, 0, 3)
+	assert.Equal(t, List[int]{0, 1, 3, 3, 4, 5}, sorted.ToList())
+	assert.Equal(t, 6, sorted.Len())
+
+	assert.True(t, sorted.Contains(4))
+	assert.False(t, sorted.Contains(2))
+	assert.Equal(t, 2, sorted.IndexOf(3))
+	assert.Equal(t, -1, sorted.IndexOf(2))
+
+	assert.True(t, sorted.Remove(3))
+	assert.False(t, sorted.Remove(2))
+	assert.Equal(t, List[int]{0, 1, 3, 4, 5}, sorted.ToList())
+
+	element, err := sorted.Get(1)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, element)
+
+	_, err = sorted.Get(5)
+	assert.ErrorIs(t, err, ErrIndexOutOfBounds)
+}
+
+func TestSortedListMatchesItemsWithDuplicateKeys(t *testing.T) {
+	type item struct {
+		ID int
+	}
+
+	byBucket := comparator.AscendingOrderBy(func(i item) int { return i.ID / 10 })
+	sorted := NewSortedList(byBucket, item{1}, item{3}, item{12}, item{5})
+
+	assert.Equal(t, 1, sorted.IndexOf(item{3}))
+	assert.True(t, sorted.Contains(item{5}))
+	assert.False(t, sorted.Contains(item{7}), "Contains() should not match an item that only shares a key")
+	assert.Equal(t, -1, sorted.IndexOf(item{7}))
+
+	assert.False(t, sorted.Remove(item{7}))
+	assert.True(t, sorted.Remove(item{3}))
+	assert.Equal(t, List[item]{{1}, {5}, {12}}, sorted.ToList(), "Remove() should delete the matching item")
+}
+
+func TestSortedListToListReturnsCopy(t *testing.T) {
+	sorted := NewSortedList(comparator.AscendingOrder[int](), 2, 1)
+
+	snapshot := sorted.ToList()
+	snapshot[0] = 100
+
+	assert.Equal(t, List[int]{1, 2}, sorted.ToList(), "ToList() should not expose internal storage")
+}
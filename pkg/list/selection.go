@@ -0,0 +1,110 @@
+package list
+
+import (
+	"container/heap"
+	"slices"
+
+	"github.com/zach-robinson-dev/kollections/pkg/comparator"
+)
+
+func (l *List[T]) TopK(k int, comparator comparator.Comparator[T]) List[T] {
+	return l.BottomK(k, comparator.Reversed())
+}
+
+func (l *List[T]) BottomK(k int, comparator comparator.Comparator[T]) List[T] {
+	k = min(max(k, 0), len(*l))
+
+	order := indexedOrder(comparator)
+	candidates := &itemHeap[T]{compare: order.Reversed(), items: make([]indexedItem[T], 0, k)}
+
+	for index, element := range *l {
+		item := indexedItem[T]{index: index, value: element}
+
+		switch {
+		case k == 0:
+		case candidates.Len() < k:
+			heap.Push(candidates, item)
+		case order(item, candidates.items[0]) < 0:
+			candidates.items[0] = item
+			heap.Fix(candidates, 0)
+		}
+	}
+
+	slices.SortFunc(candidates.items, order)
+
+	result := make(List[T], 0, k)
+
+	for _, item := range candidates.items {
+		result = append(result, item.value)
+	}
+
+	return result
+}
+
+func (l *List[T]) NthElement(n int, comparator comparator.Comparator[T]) (T, error) {
+	if err := l.checkIndex(n); err != nil {
+		var zero T
+		return zero, err
+	}
+
+	elements := slices.Clone(*l)
+	low, high := 0, len(elements)-1
+
+	for low < high {
+		lessEnd, greaterStart := partition(elements, low, high, comparator)
+
+		switch {
+		case n < lessEnd:
+			high = lessEnd - 1
+		case n >= greaterStart:
+			low = greaterStart
+		default:
+			return elements[n], nil
+		}
+	}
+
+	return elements[n], nil
+}
+
+// partition moves the median-of-three pivot to low before a three-way partition of the range, so
+// the middle region always holds at least the pivot and the search shrinks even when the
+// comparator is inconsistent, as AscendingOrder is for NaN.
+func partition[T any](elements List[T], low int, high int, comparator comparator.Comparator[T]) (int, int) {
+	pivotIndex := medianOfThree(elements, low, low+(high-low)/2, high, comparator)
+	elements[low], elements[pivotIndex] = elements[pivotIndex], elements[low]
+	pivot := elements[low]
+
+	lessEnd, current, greaterStart := low, low+1, high+1
+
+	for current < greaterStart {
+		switch result := comparator(elements[current], pivot); {
+		case result < 0:
+			elements[lessEnd], elements[current] = elements[current], elements[lessEnd]
+			lessEnd++
+			current++
+		case result > 0:
+			greaterStart--
+			elements[current], elements[greaterStart] = elements[greaterStart], elements[current]
+		default:
+			current++
+		}
+	}
+
+	return lessEnd, greaterStart
+}
+
+func medianOfThree[T any](elements List[T], a int, b int, c int, comparator comparator.Comparator[T]) int {
+	if comparator(elements[a], elements[b]) > 0 {
+		a, b = b, a
+	}
+
+	if comparator(elements[b], elements[c]) > 0 {
+		b = c
+	}
+
+	if comparator(elements[a], elements[b]) > 0 {
+		b = a
+	}
+
+	return b
+}
@@ -0,0 +1,162 @@
+package list
+
+import (
+	"math"
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zach-robinson-dev/kollections/pkg/comparator"
+)
+
+func TestTopK(t *testing.T) {
+	tests := []struct {
+		name string
+		list List[int]
+		k    int
+		want List[int]
+	}{
+		{"Empty list", List[int]{}, 3, List[int]{}},
+		{"Zero k", List[int]{1, 2, 3}, 0, List[int]{}},
+		{"Negative k", List[int]{1, 2, 3}, -1, List[int]{}},
+		{"K smaller than list", List[int]{5, 1, 9, 3, 7}, 2, List[int]{9, 7}},
+		{"K larger than list", List[int]{2, 3, 1}, 10, List[int]{3, 2, 1}},
+		{"Duplicates", List[int]{4, 4, 1, 4}, 2, List[int]{4, 4}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.list.TopK(tt.k, comparator.AscendingOrder[int]())
+			assert.Equal(t, tt.want, got, "TopK() should return expected result")
+		})
+	}
+}
+
+func TestBottomK(t *testing.T) {
+	tests := []struct {
+		name string
+		list List[int]
+		k    int
+		want List[int]
+	}{
+		{"Empty list", List[int]{}, 3, List[int]{}},
+		{"K smaller than list", List[int]{5, 1, 9, 3, 7}, 3, List[int]{1, 3, 5}},
+		{"K larger than list", List[int]{2, 3, 1}, 4, List[int]{1, 2, 3}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.list.BottomK(tt.k, comparator.AscendingOrder[int]())
+			assert.Equal(t, tt.want, got, "BottomK() should return expected result")
+		})
+	}
+}
+
+func TestTopKKeepsOriginalOrderForTies(t *testing.T) {
+	type score struct {
+		name   string
+		points int
+	}
+
+	scores := List[score]{{"a", 10}, {"b", 20}, {"c", 10}, {"d", 20}, {"e", 10}}
+	byPoints := comparator.AscendingOrderBy(func(s score) int { return s.points })
+
+	assert.Equal(t, List[score]{{"b", 20}, {"d", 20}, {"a", 10}}, scores.TopK(3, byPoints))
+	assert.Equal(t, List[score]{{"a", 10}, {"c", 10}, {"e", 10}, {"b", 20}}, scores.BottomK(4, byPoints))
+}
+
+func TestTopKMatchesSort(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	list := make(List[int], 1000)
+
+	for index := range list {
+		list[index] = random.Intn(100)
+	}
+
+	sorted := slices.Clone(list)
+	slices.Sort(sorted)
+	slices.Reverse(sorted)
+
+	assert.Equal(t, sorted[:50], list.TopK(50, comparator.AscendingOrder[int]()))
+}
+
+func TestNthElement(t *testing.T) {
+	list := List[int]{7, 3, 9, 3, 1, 8, 3}
+	sorted := slices.Clone(list)
+	slices.Sort(sorted)
+
+	for n := range list {
+		got, err := list.NthElement(n, comparator.AscendingOrder[int]())
+		assert.NoError(t, err)
+		assert.Equal(t, sorted[n], got, "NthElement(%d) should match sorted position", n)
+	}
+
+	assert.Equal(t, List[int]{7, 3, 9, 3, 1, 8, 3}, list, "NthElement() should not reorder the list")
+}
+
+func TestNthElementMatchesSort(t *testing.T) {
+	random := rand.New(rand.NewSource(7))
+
+	for range 100 {
+		list := make(List[int], 1+random.Intn(50))
+		for index := range list {
+			list[index] = random.Intn(10)
+		}
+
+		sorted := slices.Clone(list)
+		slices.Sort(sorted)
+
+		n := random.Intn(len(list))
+		got, err := list.NthElement(n, comparator.AscendingOrder[int]())
+		assert.NoError(t, err)
+		assert.Equal(t, sorted[n], got, "NthElement(%d) of %v", n, list)
+	}
+}
+
+func TestNthElementTerminatesWithInconsistentComparator(t *testing.T) {
+	nan := math.NaN()
+
+	tests := []struct {
+		name       string
+		list       List[float64]
+		comparator comparator.Comparator[float64]
+	}{
+		{"NaN pivot", List[float64]{nan, nan, nan, 1, 2}, comparator.AscendingOrder[float64]()},
+		{"Mixed NaN", List[float64]{3, nan, 1, nan, 2, nan, 0}, comparator.AscendingOrder[float64]()},
+		{"Always greater", List[float64]{5, 4, 3, 2, 1}, func(float64, float64) int { return 1 }},
+		{"Always less", List[float64]{5, 4, 3, 2, 1}, func(float64, float64) int { return -1 }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for n := range tt.list {
+				got, err := tt.list.NthElement(n, tt.comparator)
+				assert.NoError(t, err)
+				assert.True(t, slices.ContainsFunc(tt.list, func(v float64) bool { return v == got || math.IsNaN(v) && math.IsNaN(got) }),
+					"NthElement(%d) should return an element of the list", n)
+			}
+		})
+	}
+}
+
+func TestNthElementOutOfBounds(t *testing.T) {
+	tests := []struct {
+		name string
+		list List[int]
+		n    int
+	}{
+		{"Empty list", List[int]{}, 0},
+		{"Negative index", List[int]{1, 2}, -1},
+		{"Index equal to length", List[int]{1, 2}, 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.list.NthElement(tt.n, comparator.AscendingOrder[int]())
+			assert.ErrorIs(t, err, ErrIndexOutOfBounds, "NthElement() should return expected error")
+			assert.Zero(t, got)
+		})
+	}
+}
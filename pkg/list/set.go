@@ -0,0 +1,91 @@
+package list
+
+import "reflect"
+
+func (l *List[T]) Distinct() List[T] {
+	return DistinctBy(*l, func(item T) T { return item })
+}
+
+func DistinctBy[T any, K any](list List[T], keySelector TransformFunc[T, K]) List[T] {
+	seen := newSeenSet[K]()
+	result := make(List[T], 0, len(list))
+
+	for _, element := range list {
+		if seen.add(keySelector(element)) {
+			result = append(result, element)
+		}
+	}
+
+	return result
+}
+
+func (l *List[T]) Union(other List[T]) List[T] {
+	combined := make(List[T], 0, len(*l)+len(other))
+	combined = append(append(combined, *l...), other...)
+
+	return combined.Distinct()
+}
+
+func (l *List[T]) Intersect(other List[T]) List[T] {
+	return l.retainDistinct(other, true)
+}
+
+func (l *List[T]) Subtract(other List[T]) List[T] {
+	return l.retainDistinct(other, false)
+}
+
+func (l *List[T]) retainDistinct(other List[T], keepShared bool) List[T] {
+	otherElements := newSeenSet[T]()
+
+	for _, element := range other {
+		otherElements.add(element)
+	}
+
+	seen := newSeenSet[T]()
+	result := make(List[T], 0, len(*l))
+
+	for _, element := range *l {
+		if otherElements.contains(element) == keepShared && seen.add(element) {
+			result = append(result, element)
+		}
+	}
+
+	return result
+}
+
+type seenSet[T any] struct {
+	hashed   map[any]struct{}
+	unhashed List[T]
+}
+
+func newSeenSet[T any]() *seenSet[T] {
+	return &seenSet[T]{hashed: make(map[any]struct{})}
+}
+
+func (s *seenSet[T]) contains(item T) bool {
+	switch isHashable(item) {
+	case true:
+		_, isPresent := s.hashed[item]
+		return isPresent
+	default:
+		return s.unhashed.Contains(item)
+	}
+}
+
+func (s *seenSet[T]) add(item T) bool {
+	switch {
+	case s.contains(item):
+		return false
+	case isHashable(item):
+		s.hashed[item] = struct{}{}
+	default:
+		s.unhashed = append(s.unhashed, item)
+	}
+
+	return true
+}
+
+func isHashable(value any) bool {
+	reflectValue := reflect.ValueOf(value)
+	return !reflectValue.IsValid() || reflectValue.Comparable()
+}
@@ -0,0 +1,120 @@
+package list
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDistinct(t *testing.T) {
+	tests := []struct {
+		name string
+		list List[int]
+		want List[int]
+	}{
+		{"Empty list", List[int]{}, List[int]{}},
+		{"No duplicates", List[int]{3, 1, 2}, List[int]{3, 1, 2}},
+		{"Keeps first occurrence order", List[int]{3, 1, 3, 2, 1}, List[int]{3, 1, 2}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.list.Distinct()
+			assert.Equal(t, tt.want, got, "Distinct() should return expected result")
+		})
+	}
+}
+
+func TestDistinctWithUncomparableElements(t *testing.T) {
+	list := List[[]int]{{1, 2}, {3}, {1, 2}, {}, {3}}
+
+	assert.Equal(t, List[[]int]{{1, 2}, {3}, {}}, list.Distinct(), "Distinct() should fall back to deep equality")
+}
+
+func TestDistinctWithMixedInterfaceElements(t *testing.T) {
+	list := List[any]{1, []string{"a"}, "x", 1, []string{"a"}, nil, nil, map[string]int{"k": 1}, map[string]int{"k": 1}}
+
+	assert.Equal(t, List[any]{1, []string{"a"}, "x", nil, map[string]int{"k": 1}}, list.Distinct())
+}
+
+func TestDistinctBy(t *testing.T) {
+	type user struct {
+		id   int
+		name string
+	}
+
+	users := List[user]{{1, "ann"}, {2, "bob"}, {1, "ann (updated)"}, {3, "cid"}}
+
+	got := DistinctBy(users, func(u user) int { return u.id })
+
+	assert.Equal(t, List[user]{{1, "ann"}, {2, "bob"}, {3, "cid"}}, got, "DistinctBy() should keep the first element per key")
+}
+
+func TestUnion(t *testing.T) {
+	tests := []struct {
+		name  string
+		list  List[int]
+		other List[int]
+		want  List[int]
+	}{
+		{"Both empty", List[int]{}, List[int]{}, List[int]{}},
+		{"Disjoint", List[int]{1, 2}, List[int]{3}, List[int]{1, 2, 3}},
+		{"Overlapping", List[int]{2, 1, 2}, List[int]{3, 1, 4}, List[int]{2, 1, 3, 4}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.list.Union(tt.other)
+			assert.Equal(t, tt.want, got, "Union() should return expected result")
+		})
+	}
+}
+
+func TestIntersect(t *testing.T) {
+	tests := []struct {
+		name  string
+		list  List[int]
+		other List[int]
+		want  List[int]
+	}{
+		{"Empty other", List[int]{1, 2}, List[int]{}, List[int]{}},
+		{"Disjoint", List[int]{1, 2}, List[int]{3}, List[int]{}},
+		{"Overlapping keeps receiver order", List[int]{4, 1, 3, 1, 2}, List[int]{1, 2, 4}, List[int]{4, 1, 2}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.list.Intersect(tt.other)
+			assert.Equal(t, tt.want, got, "Intersect() should return expected result")
+		})
+	}
+}
+
+func TestSubtract(t *testing.T) {
+	tests := []struct {
+		name  string
+		list  List[int]
+		other List[int]
+		want  List[int]
+	}{
+		{"Empty other", List[int]{1, 2, 1}, List[int]{}, List[int]{1, 2}},
+		{"Remove all", List[int]{1, 2}, List[int]{2, 1}, List[int]{}},
+		{"Overlapping keeps receiver order", List[int]{5, 1, 3, 5, 2}, List[int]{1, 2}, List[int]{5, 3}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.list.Subtract(tt.other)
+			assert.Equal(t, tt.want, got, "Subtract() should return expected result")
+		})
+	}
+}
+
+func TestSetOperationsWithUncomparableElements(t *testing.T) {
+	list := List[[]string]{{"a"}, {"b"}, {"c"}}
+	other := List[[]string]{{"b"}, {"d"}}
+
+	assert.Equal(t, List[[]string]{{"b"}}, list.Intersect(other))
+	assert.Equal(t, List[[]string]{{"a"}, {"c"}}, list.Subtract(other))
+	assert.Equal(t, List[[]string]{{"a"}, {"b"}, {"c"}, {"d"}}, list.Union(other))
+}
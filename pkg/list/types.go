@@ -1,7 +1,47 @@
 package list
 
+import (
+	"golang.org/x/exp/constraints"
+
+	"github.com/zach-robinson-dev/kollections/pkg/comparator"
+)
+
 type List[T any] []T
 
 type PredicateFunc[T any] func(item T) bool
 
+type IndexedPredicateFunc[T any] func(index int, item T) bool
+
 type TransformFunc[T any, R any] func(item T) R
+
+type IndexedTransformFunc[T any, R any] func(index int, item T) R
+
+type IndexedActionFunc[T any] func(index int, item T)
+
+type CombineFunc[A any, B any, R any] func(left A, right B) R
+
+type AccumulatorFunc[T any, R any] func(accumulator R, item T) R
+
+type IndexedAccumulatorFunc[T any, R any] func(index int, accumulator R, item T) R
+
+type Number interface {
+	constraints.Integer | constraints.Float
+}
+
+type Iterator[T any] interface {
+	Next() (T, bool)
+}
+
+type SortedList[T any] struct {
+	comparator comparator.Comparator[T]
+	elements   List[T]
+}
+
+type JoinOptions[T any] struct {
+	Separator string
+	Prefix    string
+	Postfix   string
+	Limit     int
+	Truncated string
+	Transform TransformFunc[T, string]
+}
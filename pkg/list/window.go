@@ -0,0 +1,52 @@
+package list
+
+import "fmt"
+
+func Chunked[T any](list List[T], size int) (List[List[T]], error) {
+	return ChunkedTransform(list, size, cloneWindow[T])
+}
+
+func ChunkedTransform[T any, R any](list List[T], size int, transform TransformFunc[List[T], R]) (List[R], error) {
+	return WindowedTransform(list, size, size, true, transform)
+}
+
+func Windowed[T any](list List[T], size int, step int, partialWindows bool) (List[List[T]], error) {
+	return WindowedTransform(list, size, step, partialWindows, cloneWindow[T])
+}
+
+func WindowedTransform[T any, R any](list List[T], size int, step int, partialWindows bool, transform TransformFunc[List[T], R]) (List[R], error) {
+	if size <= 0 || step <= 0 {
+		return nil, fmt.Errorf("%w: got size %d and step %d", ErrInvalidWindow, size, step)
+	}
+
+	result := make(List[R], 0, windowCount(len(list), size, step, partialWindows))
+
+	for start := 0; start < len(list); start += step {
+		end := start + min(size, len(list)-start)
+
+		if end-start < size && !partialWindows {
+			return result, nil
+		}
+
+		result = append(result, transform(list[start:end:end]))
+	}
+
+	return result, nil
+}
+
+func windowCount(length int, size int, step int, partialWindows bool) int {
+	switch {
+	case length == 0:
+		return 0
+	case partialWindows:
+		return (length-1)/step + 1
+	case length < size:
+		return 0
+	default:
+		return (length-size)/step + 1
+	}
+}
+
+func cloneWindow[T any](window List[T]) List[T] {
+	return append(make(List[T], 0, len(window)), window...)
+}
@@ -0,0 +1,121 @@
+package list
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChunked(t *testing.T) {
+	tests := []struct {
+		name string
+		list List[int]
+		size int
+		want List[List[int]]
+	}{
+		{"Empty list", List[int]{}, 2, List[List[int]]{}},
+		{"Evenly divisible", List[int]{1, 2, 3, 4}, 2, List[List[int]]{{1, 2}, {3, 4}}},
+		{"Partial last chunk", List[int]{1, 2, 3, 4, 5}, 2, List[List[int]]{{1, 2}, {3, 4}, {5}}},
+		{"Size larger than list", List[int]{1, 2}, 5, List[List[int]]{{1, 2}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Chunked(tt.list, tt.size)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got, "Chunked() should return expected result")
+		})
+	}
+}
+
+func TestChunkedDoesNotAlias(t *testing.T) {
+	list := List[int]{1, 2, 3, 4}
+
+	chunks, err := Chunked(list, 2)
+	assert.NoError(t, err)
+
+	chunks[0][0] = 100
+	chunks[0] = append(chunks[0], 200)
+
+	assert.Equal(t, List[int]{1, 2, 3, 4}, list, "Chunked() should copy elements into new lists")
+}
+
+func TestChunkedTransform(t *testing.T) {
+	got, err := ChunkedTransform(List[int]{1, 2, 3, 4, 5}, 2, func(chunk List[int]) int {
+		return Fold(chunk, 0, func(acc int, item int) int { return acc + item })
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, List[int]{3, 7, 5}, got, "ChunkedTransform() should return expected result")
+}
+
+func TestWindowed(t *testing.T) {
+	tests := []struct {
+		name           string
+		list           List[int]
+		size           int
+		step           int
+		partialWindows bool
+		want           List[List[int]]
+	}{
+		{"Empty list", List[int]{}, 2, 1, true, List[List[int]]{}},
+		{"Sliding by one", List[int]{1, 2, 3, 4}, 2, 1, false, List[List[int]]{{1, 2}, {2, 3}, {3, 4}}},
+		{"Sliding by one with partial windows", List[int]{1, 2, 3}, 2, 1, true, List[List[int]]{{1, 2}, {2, 3}, {3}}},
+		{"Step larger than size", List[int]{1, 2, 3, 4, 5, 6}, 2, 3, false, List[List[int]]{{1, 2}, {4, 5}}},
+		{"Trailing partial window dropped", List[int]{1, 2, 3, 4, 5}, 3, 2, false, List[List[int]]{{1, 2, 3}, {3, 4, 5}}},
+		{"Trailing partial window kept", List[int]{1, 2, 3, 4}, 3, 2, true, List[List[int]]{{1, 2, 3}, {3, 4}}},
+		{"Size larger than list", List[int]{1, 2}, 3, 1, false, List[List[int]]{}},
+		{"Maximum size with partial windows", List[int]{1, 2, 3}, math.MaxInt, 1, true, List[List[int]]{{1, 2, 3}, {2, 3}, {3}}},
+		{"Maximum size without partial windows", List[int]{1, 2, 3}, math.MaxInt, 1, false, List[List[int]]{}},
+		{"Maximum step", List[int]{1, 2, 3}, 2, math.MaxInt, true, List[List[int]]{{1, 2}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Windowed(tt.list, tt.size, tt.step, tt.partialWindows)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got, "Windowed() should return expected result")
+		})
+	}
+}
+
+func TestWindowedTransform(t *testing.T) {
+	movingAverage, err := WindowedTransform(List[float64]{1, 2, 3, 4, 5}, 3, 1, false, func(window List[float64]) float64 {
+		return Fold(window, 0.0, func(acc float64, item float64) float64 { return acc + item }) / float64(len(window))
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, List[float64]{2, 3, 4}, movingAverage, "WindowedTransform() should return expected result")
+}
+
+func TestWindowedTransformDoesNotOverwriteSource(t *testing.T) {
+	list := List[int]{1, 2, 3, 4}
+
+	_, err := WindowedTransform(list, 2, 2, false, func(window List[int]) List[int] { return append(window, 0) })
+
+	assert.NoError(t, err)
+	assert.Equal(t, List[int]{1, 2, 3, 4}, list, "appending to a window should not overwrite the source list")
+}
+
+func TestWindowedInvalidArguments(t *testing.T) {
+	list := List[int]{1, 2, 3}
+
+	tests := []struct {
+		name string
+		call func() (List[List[int]], error)
+	}{
+		{"Windowed with zero size", func() (List[List[int]], error) { return Windowed(list, 0, 1, false) }},
+		{"Windowed with zero step", func() (List[List[int]], error) { return Windowed(list, 1, 0, false) }},
+		{"Chunked with negative size", func() (List[List[int]], error) { return Chunked(list, -1) }},
+		{"Chunked on empty list", func() (List[List[int]], error) { return Chunked(List[int]{}, 0) }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.call()
+			assert.ErrorIs(t, err, ErrInvalidWindow, "should reject non-positive size or step")
+			assert.Nil(t, got)
+		})
+	}
+}
@@ -0,0 +1,9 @@
+package _map
+
+import (
+	"fmt"
+
+	"github.com/zach-robinson-dev/kollections/pkg/list"
+)
+
+var ErrEmptyMap = fmt.Errorf("%w: map is empty", list.ErrNoSuchElement)
@@ -0,0 +1,73 @@
+package _map
+
+import (
+	"golang.org/x/exp/constraints"
+
+	"github.com/zach-robinson-dev/kollections/pkg/comparator"
+	"github.com/zach-robinson-dev/kollections/pkg/list"
+)
+
+func MinBy[K comparable, V any, C constraints.Ordered](m Map[K, V], selector comparator.Selector[V, C]) (Pair[K, V], error) {
+	return extremeEntry(list.MinBy[Pair[K, V], C], m, selector)
+}
+
+func MaxBy[K comparable, V any, C constraints.Ordered](m Map[K, V], selector comparator.Selector[V, C]) (Pair[K, V], error) {
+	return extremeEntry(list.MaxBy[Pair[K, V], C], m, selector)
+}
+
+func MinOf[K comparable, V any, C constraints.Ordered](m Map[K, V], selector comparator.Selector[V, C]) (C, error) {
+	return extremeValue(MinBy[K, V, C], m, selector)
+}
+
+func MaxOf[K comparable, V any, C constraints.Ordered](m Map[K, V], selector comparator.Selector[V, C]) (C, error) {
+	return extremeValue(MaxBy[K, V, C], m, selector)
+}
+
+func (m *Map[K, V]) MinMaxWith(valueComparator comparator.Comparator[V]) (Pair[K, V], Pair[K, V], error) {
+	entries := m.Entries()
+
+	minEntry, maxEntry, err := entries.MinMaxWith(comparator.BySelector(valueComparator, pairValue[K, V]))
+	if err != nil {
+		return minEntry, maxEntry, ErrEmptyMap
+	}
+
+	return minEntry, maxEntry, nil
+}
+
+// Entries are returned in map iteration order, so ties in the helpers above resolve arbitrarily.
+func (m *Map[K, V]) Entries() list.List[Pair[K, V]] {
+	entries := make(list.List[Pair[K, V]], 0, len(*m))
+
+	for key, value := range *m {
+		entries = append(entries, Pair[K, V]{Key: key, Value: value})
+	}
+
+	return entries
+}
+
+func extremeEntry[K comparable, V any, C constraints.Ordered](
+	extreme func(list.List[Pair[K, V]], comparator.Selector[Pair[K, V], C]) (Pair[K, V], error),
+	m Map[K, V],
+	selector comparator.Selector[V, C],
+) (Pair[K, V], error) {
+	entry, err := extreme(m.Entries(), func(entry Pair[K, V]) C { return selector(entry.Value) })
+	if err != nil {
+		return entry, ErrEmptyMap
+	}
+
+	return entry, nil
+}
+
+func extremeValue[K comparable, V any, C constraints.Ordered](
+	extreme func(Map[K, V], comparator.Selector[V, C]) (Pair[K, V], error),
+	m Map[K, V],
+	selector comparator.Selector[V, C],
+) (C, error) {
+	entry, err := extreme(m, selector)
+	if err != nil {
+		var zero C
+		return zero, err
+	}
+
+	return selector(entry.Value), nil
+}
@@ -0,0 +1,75 @@
+package _map
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zach-robinson-dev/kollections/pkg/comparator"
+	"github.com/zach-robinson-dev/kollections/pkg/list"
+)
+
+func TestMapMinByAndMaxBy(t *testing.T) {
+	identity := func(value int) int { return value }
+
+	tests := []struct {
+		name    string
+		m       Map[string, int]
+		wantMin Pair[string, int]
+		wantMax Pair[string, int]
+		wantErr error
+	}{
+		{"Empty map", Map[string, int]{}, Pair[string, int]{}, Pair[string, int]{}, ErrEmptyMap},
+		{"Single entry", Map[string, int]{"a": 1}, Pair[string, int]{"a", 1}, Pair[string, int]{"a", 1}, nil},
+		{"Multiple entries", Map[string, int]{"a": 5, "b": -1, "c": 9}, Pair[string, int]{"b", -1}, Pair[string, int]{"c", 9}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			minimum, err := MinBy(tt.m, identity)
+			assert.ErrorIs(t, err, tt.wantErr, "MinBy() should return expected error")
+			assert.Equal(t, tt.wantMin, minimum, "MinBy() should return expected entry")
+
+			maximum, err := MaxBy(tt.m, identity)
+			assert.ErrorIs(t, err, tt.wantErr, "MaxBy() should return expected error")
+			assert.Equal(t, tt.wantMax, maximum, "MaxBy() should return expected entry")
+		})
+	}
+}
+
+func TestMapMinOfAndMaxOf(t *testing.T) {
+	m := Map[int, string]{1: "three", 2: "a", 3: "go"}
+	byLength := func(value string) int { return len(value) }
+
+	minimum, err := MinOf(m, byLength)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, minimum)
+
+	maximum, err := MaxOf(m, byLength)
+	assert.NoError(t, err)
+	assert.Equal(t, 5, maximum)
+
+	_, err = MinOf(Map[int, string]{}, byLength)
+	assert.ErrorIs(t, err, ErrEmptyMap)
+	assert.ErrorIs(t, err, list.ErrNoSuchElement)
+}
+
+func TestMapMinMaxWith(t *testing.T) {
+	m := Map[string, int]{"a": 3, "b": 1, "c": 2}
+
+	minimum, maximum, err := m.MinMaxWith(comparator.AscendingOrder[int]())
+	assert.NoError(t, err)
+	assert.Equal(t, Pair[string, int]{"b", 1}, minimum)
+	assert.Equal(t, Pair[string, int]{"a", 3}, maximum)
+
+	empty := Map[string, int]{}
+	_, _, err = empty.MinMaxWith(comparator.AscendingOrder[int]())
+	assert.ErrorIs(t, err, ErrEmptyMap)
+}
+
+func TestEntries(t *testing.T) {
+	m := Map[string, int]{"a": 1, "b": 2}
+
+	assert.ElementsMatch(t, list.List[Pair[string, int]]{{"a", 1}, {"b", 2}}, m.Entries())
+	assert.Empty(t, (&Map[string, int]{}).Entries())
+}
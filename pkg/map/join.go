@@ -0,0 +1,23 @@
+package _map
+
+import (
+	"io"
+	"strings"
+
+	"github.com/zach-robinson-dev/kollections/pkg/comparator"
+	"github.com/zach-robinson-dev/kollections/pkg/list"
+)
+
+func (m *Map[K, V]) JoinToString(keyComparator comparator.Comparator[K], options list.JoinOptions[Pair[K, V]]) string {
+	var builder strings.Builder
+
+	_, _ = m.AppendTo(&builder, keyComparator, options)
+
+	return builder.String()
+}
+
+func (m *Map[K, V]) AppendTo(writer io.Writer, keyComparator comparator.Comparator[K], options list.JoinOptions[Pair[K, V]]) (int, error) {
+	entries := m.SortedEntries(comparator.BySelector(keyComparator, pairKey[K, V]))
+
+	return entries.AppendTo(writer, options)
+}
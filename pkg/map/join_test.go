@@ -0,0 +1,58 @@
+package _map
+
+import (
+	"bytes"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zach-robinson-dev/kollections/pkg/comparator"
+	"github.com/zach-robinson-dev/kollections/pkg/list"
+)
+
+func TestMapJoinToString(t *testing.T) {
+	m := Map[string, int]{"b": 2, "c": 3, "a": 1}
+
+	tests := []struct {
+		name          string
+		keyComparator comparator.Comparator[string]
+		options       list.JoinOptions[Pair[string, int]]
+		want          string
+	}{
+		{"Ascending keys", comparator.AscendingOrder[string](), list.DefaultJoinOptions[Pair[string, int]](), "a=1, b=2, c=3"},
+		{"Descending keys", comparator.DescendingOrder[string](), list.DefaultJoinOptions[Pair[string, int]](), "c=3, b=2, a=1"},
+		{
+			name:          "Limit and custom transform",
+			keyComparator: comparator.AscendingOrder[string](),
+			options: list.JoinOptions[Pair[string, int]]{
+				Separator: " & ",
+				Prefix:    "{",
+				Postfix:   "}",
+				Limit:     2,
+				Truncated: "…",
+				Transform: func(entry Pair[string, int]) string { return entry.Key + ":" + strconv.Itoa(entry.Value) },
+			},
+			want: "{a:1 & b:2 & …}",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := m.JoinToString(tt.keyComparator, tt.options)
+			assert.Equal(t, tt.want, got, "JoinToString() should return expected result")
+		})
+	}
+}
+
+func TestMapAppendTo(t *testing.T) {
+	m := Map[int, string]{2: "two", 1: "one"}
+
+	var buffer bytes.Buffer
+
+	written, err := m.AppendTo(&buffer, comparator.AscendingOrder[int](), list.JoinOptions[Pair[int, string]]{Separator: "\n"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "1=one\n2=two", buffer.String())
+	assert.Equal(t, buffer.Len(), written)
+}
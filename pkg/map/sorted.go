@@ -0,0 +1,47 @@
+package _map
+
+import (
+	"slices"
+
+	"github.com/zach-robinson-dev/kollections/pkg/comparator"
+	"github.com/zach-robinson-dev/kollections/pkg/list"
+)
+
+func (m *Map[K, V]) SortedKeys(keyComparator comparator.Comparator[K]) list.List[K] {
+	keys := make(list.List[K], 0, len(*m))
+
+	for key := range *m {
+		keys = append(keys, key)
+	}
+
+	slices.SortFunc(keys, keyComparator)
+
+	return keys
+}
+
+func (m *Map[K, V]) ForEachSorted(keyComparator comparator.Comparator[K], action ActionFunc[K, V]) {
+	for _, key := range m.SortedKeys(keyComparator) {
+		action(key, (*m)[key])
+	}
+}
+
+func (m *Map[K, V]) SortedEntries(entryComparator comparator.Comparator[Pair[K, V]]) list.List[Pair[K, V]] {
+	entries := m.Entries()
+	slices.SortFunc(entries, entryComparator)
+
+	return entries
+}
+
+func (m *Map[K, V]) SortedByValue(valueComparator comparator.Comparator[V], keyComparator comparator.Comparator[K]) list.List[Pair[K, V]] {
+	return m.SortedEntries(
+		comparator.BySelector(valueComparator, pairValue[K, V]).Then(comparator.BySelector(keyComparator, pairKey[K, V])),
+	)
+}
+
+func pairKey[K comparable, V any](entry Pair[K, V]) K {
+	return entry.Key
+}
+
+func pairValue[K comparable, V any](entry Pair[K, V]) V {
+	return entry.Value
+}
@@ -0,0 +1,72 @@
+package _map
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zach-robinson-dev/kollections/pkg/comparator"
+	"github.com/zach-robinson-dev/kollections/pkg/list"
+)
+
+func TestSortedKeys(t *testing.T) {
+	tests := []struct {
+		name          string
+		m             Map[string, int]
+		keyComparator comparator.Comparator[string]
+		want          list.List[string]
+	}{
+		{"Empty map", Map[string, int]{}, comparator.AscendingOrder[string](), list.List[string]{}},
+		{"Ascending", Map[string, int]{"b": 1, "c": 2, "a": 3}, comparator.AscendingOrder[string](), list.List[string]{"a", "b", "c"}},
+		{"Descending", Map[string, int]{"b": 1, "c": 2, "a": 3}, comparator.DescendingOrder[string](), list.List[string]{"c", "b", "a"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.m.SortedKeys(tt.keyComparator)
+			assert.Equal(t, tt.want, got, "SortedKeys() should return expected result")
+		})
+	}
+}
+
+func TestForEachSorted(t *testing.T) {
+	m := Map[int, string]{3: "c", 1: "a", 2: "b"}
+
+	var visited []string
+	m.ForEachSorted(comparator.AscendingOrder[int](), func(key int, value string) {
+		visited = append(visited, value)
+	})
+
+	assert.Equal(t, []string{"a", "b", "c"}, visited, "ForEachSorted() should visit entries in key order")
+}
+
+func TestSortedEntries(t *testing.T) {
+	m := Map[string, int]{"bb": 1, "a": 2, "ccc": 0}
+
+	byKeyLength := comparator.AscendingOrderBy(func(entry Pair[string, int]) int { return len(entry.Key) })
+
+	got := m.SortedEntries(byKeyLength)
+
+	assert.Equal(t, list.List[Pair[string, int]]{{"a", 2}, {"bb", 1}, {"ccc", 0}}, got)
+}
+
+func TestSortedByValue(t *testing.T) {
+	m := Map[string, int]{"d": 2, "a": 1, "c": 2, "b": 3}
+
+	got := m.SortedByValue(comparator.DescendingOrder[int](), comparator.AscendingOrder[string]())
+
+	assert.Equal(t, list.List[Pair[string, int]]{{"b", 3}, {"c", 2}, {"d", 2}, {"a", 1}}, got, "SortedByValue() should break value ties by key")
+}
+
+func TestSortedIterationIsDeterministic(t *testing.T) {
+	m := Map[int, int]{}
+	for key := 0; key < 100; key++ {
+		m[key] = key % 7
+	}
+
+	first := m.SortedByValue(comparator.AscendingOrder[int](), comparator.AscendingOrder[int]())
+
+	for attempt := 0; attempt < 10; attempt++ {
+		assert.Equal(t, first, m.SortedByValue(comparator.AscendingOrder[int](), comparator.AscendingOrder[int]()))
+	}
+}
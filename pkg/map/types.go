@@ -1,5 +1,18 @@
 package _map
 
+import "fmt"
+
 type Map[K comparable, V any] map[K]V
 
 type PredicateFunc[K comparable, V any] func(key K, value V) bool
+
+type Pair[K comparable, V any] struct {
+	Key   K
+	Value V
+}
+
+type ActionFunc[K comparable, V any] func(key K, value V)
+
+func (p Pair[K, V]) String() string {
+	return fmt.Sprintf("%v=%v", p.Key, p.Value)
+}
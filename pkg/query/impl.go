@@ -0,0 +1,165 @@
+package query
+
+import (
+	"slices"
+
+	"golang.org/x/exp/constraints"
+
+	"github.com/zach-robinson-dev/kollections/pkg/comparator"
+	"github.com/zach-robinson-dev/kollections/pkg/list"
+)
+
+func From[T any](source list.List[T]) *Query[T] {
+	return &Query[T]{source: func() list.List[T] { return slices.Clone(source) }}
+}
+
+func (q *Query[T]) Where(predicate list.PredicateFunc[T]) *Query[T] {
+	return q.then(func(elements list.List[T]) list.List[T] {
+		return elements.Filter(predicate)
+	})
+}
+
+func (q *Query[T]) OrderBy(comparator comparator.Comparator[T]) *Query[T] {
+	ordered := q.then(nil)
+	ordered.ordering = comparator
+
+	return ordered
+}
+
+func (q *Query[T]) OrderByDescending(comparator comparator.Comparator[T]) *Query[T] {
+	return q.OrderBy(comparator.Reversed())
+}
+
+// ThenBy refines the most recent ordering. When stages such as Where, Skip or Take follow that
+// ordering, the refined ordering replaces its sort stage, so those stages see the refined order.
+// Without any ordering it behaves like OrderBy.
+func (q *Query[T]) ThenBy(comparator comparator.Comparator[T]) *Query[T] {
+	switch {
+	case q.ordering != nil:
+		ordered := q.copy()
+		ordered.ordering = q.ordering.Then(comparator)
+		return ordered
+	case q.sortedBy != nil:
+		refined := q.copy()
+		refined.sortedBy = q.sortedBy.Then(comparator)
+		refined.stages[q.sortStage] = sortStage(refined.sortedBy)
+		return refined
+	default:
+		return q.OrderBy(comparator)
+	}
+}
+
+func (q *Query[T]) ThenByDescending(comparator comparator.Comparator[T]) *Query[T] {
+	return q.ThenBy(comparator.Reversed())
+}
+
+func (q *Query[T]) Skip(n int) *Query[T] {
+	return q.then(func(elements list.List[T]) list.List[T] {
+		return elements[min(max(n, 0), len(elements)):]
+	})
+}
+
+func (q *Query[T]) Take(n int) *Query[T] {
+	return q.then(func(elements list.List[T]) list.List[T] {
+		return elements[:min(max(n, 0), len(elements))]
+	})
+}
+
+func (q *Query[T]) ToList() list.List[T] {
+	elements := q.source()
+
+	for _, stage := range q.stages {
+		elements = stage(elements)
+	}
+
+	if q.ordering != nil {
+		slices.SortStableFunc(elements, q.ordering)
+	}
+
+	return elements
+}
+
+func (q *Query[T]) Count() int {
+	return len(q.ToList())
+}
+
+func (q *Query[T]) First() (T, error) {
+	elements := q.ToList()
+	return elements.First()
+}
+
+func Select[T any, R any](q *Query[T], transform list.TransformFunc[T, R]) *Query[R] {
+	return &Query[R]{source: func() list.List[R] {
+		return list.Map(q.ToList(), transform)
+	}}
+}
+
+func GroupBy[T any, K comparable, R any](q *Query[T], keySelector list.TransformFunc[T, K], projection ProjectionFunc[K, T, R]) *Query[R] {
+	return &Query[R]{source: func() list.List[R] {
+		keys := make(list.List[K], 0)
+		groups := make(map[K]list.List[T])
+
+		for _, element := range q.ToList() {
+			key := keySelector(element)
+
+			if _, isPresent := groups[key]; !isPresent {
+				keys = append(keys, key)
+			}
+
+			groups[key] = append(groups[key], element)
+		}
+
+		return list.Map(keys, func(key K) R { return projection(key, groups[key]) })
+	}}
+}
+
+// OrderBy, OrderByDescending, ThenBy and ThenByDescending are the key selector forms of the
+// corresponding Query methods.
+func OrderBy[T any, K constraints.Ordered](q *Query[T], selector comparator.Selector[T, K]) *Query[T] {
+	return q.OrderBy(comparator.AscendingOrderBy(selector))
+}
+
+func OrderByDescending[T any, K constraints.Ordered](q *Query[T], selector comparator.Selector[T, K]) *Query[T] {
+	return q.OrderBy(comparator.DescendingOrderBy(selector))
+}
+
+func ThenBy[T any, K constraints.Ordered](q *Query[T], selector comparator.Selector[T, K]) *Query[T] {
+	return q.ThenBy(comparator.AscendingOrderBy(selector))
+}
+
+func ThenByDescending[T any, K constraints.Ordered](q *Query[T], selector comparator.Selector[T, K]) *Query[T] {
+	return q.ThenBy(comparator.DescendingOrderBy(selector))
+}
+
+func (q *Query[T]) then(next stage[T]) *Query[T] {
+	result := q.copy()
+
+	if result.ordering != nil {
+		result.sortedBy, result.sortStage = result.ordering, len(result.stages)
+		result.stages = append(result.stages, sortStage(result.ordering))
+		result.ordering = nil
+	}
+
+	if next != nil {
+		result.stages = append(result.stages, next)
+	}
+
+	return result
+}
+
+func (q *Query[T]) copy() *Query[T] {
+	return &Query[T]{
+		source:    q.source,
+		stages:    slices.Clone(q.stages),
+		ordering:  q.ordering,
+		sortedBy:  q.sortedBy,
+		sortStage: q.sortStage,
+	}
+}
+
+func sortStage[T any](ordering comparator.Comparator[T]) stage[T] {
+	return func(elements list.List[T]) list.List[T] {
+		slices.SortStableFunc(elements, ordering)
+		return elements
+	}
+}
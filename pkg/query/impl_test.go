@@ -0,0 +1,169 @@
+package query
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zach-robinson-dev/kollections/pkg/comparator"
+	"github.com/zach-robinson-dev/kollections/pkg/list"
+)
+
+type ticket struct {
+	id       int
+	team     string
+	priority int
+	hours    float64
+}
+
+var tickets = list.List[ticket]{
+	{1, "api", 2, 3},
+	{2, "web", 1, 5},
+	{3, "api", 1, 2},
+	{4, "ops", 3, 8},
+	{5, "web", 2, 1},
+	{6, "api", 1, 4},
+}
+
+func ids(elements list.List[ticket]) list.List[int] {
+	return list.Map(elements, func(t ticket) int { return t.id })
+}
+
+func TestWhere(t *testing.T) {
+	got := From(tickets).Where(func(t ticket) bool { return t.team == "api" }).ToList()
+
+	assert.Equal(t, list.List[int]{1, 3, 6}, ids(got))
+}
+
+func TestOrderByThenBy(t *testing.T) {
+	byPriority := comparator.AscendingOrderBy(func(t ticket) int { return t.priority })
+	byHours := comparator.AscendingOrderBy(func(t ticket) float64 { return t.hours })
+	byTeam := comparator.AscendingOrderBy(func(t ticket) string { return t.team })
+
+	tests := []struct {
+		name  string
+		query *Query[ticket]
+		want  list.List[int]
+	}{
+		{"OrderBy is stable", From(tickets).OrderBy(byPriority), list.List[int]{2, 3, 6, 1, 5, 4}},
+		{"OrderByDescending", From(tickets).OrderByDescending(byHours), list.List[int]{4, 2, 6, 1, 3, 5}},
+		{"ThenBy", From(tickets).OrderBy(byPriority).ThenBy(byHours), list.List[int]{3, 6, 2, 5, 1, 4}},
+		{"ThenByDescending", From(tickets).OrderBy(byTeam).ThenByDescending(byHours), list.List[int]{6, 1, 3, 4, 2, 5}},
+		{"ThenBy without OrderBy", From(tickets).ThenBy(byHours), list.List[int]{5, 3, 1, 6, 2, 4}},
+		{"Second OrderBy takes precedence", From(tickets).OrderBy(byHours).OrderBy(byPriority), list.List[int]{3, 6, 2, 5, 1, 4}},
+		{"ThenBy after Where keeps primary ordering", From(tickets).OrderBy(byPriority).Where(func(t ticket) bool { return t.id != 2 }).ThenBy(byHours), list.List[int]{3, 6, 5, 1, 4}},
+		{"ThenBy after Take keeps primary ordering", From(tickets).OrderBy(byPriority).Take(4).ThenByDescending(byHours), list.List[int]{2, 6, 3, 1}},
+		{"ThenBy after Take refines before truncating", From(tickets).OrderBy(byPriority).Take(2).ThenByDescending(byHours), list.List[int]{2, 6}},
+		{"ThenBy after Skip refines before skipping", From(tickets).OrderBy(byPriority).Skip(1).Take(2).ThenBy(byHours), list.List[int]{6, 2}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ids(tt.query.ToList()))
+		})
+	}
+}
+
+func TestOrderByThenBySelectors(t *testing.T) {
+	tests := []struct {
+		name  string
+		query *Query[ticket]
+		want  list.List[int]
+	}{
+		{"OrderBy", OrderBy(From(tickets), func(t ticket) int { return t.priority }), list.List[int]{2, 3, 6, 1, 5, 4}},
+		{"OrderByDescending", OrderByDescending(From(tickets), func(t ticket) float64 { return t.hours }), list.List[int]{4, 2, 6, 1, 3, 5}},
+		{"ThenBy", ThenBy(OrderBy(From(tickets), func(t ticket) int { return t.priority }), func(t ticket) float64 { return t.hours }), list.List[int]{3, 6, 2, 5, 1, 4}},
+		{"ThenByDescending", ThenByDescending(OrderBy(From(tickets), func(t ticket) string { return t.team }), func(t ticket) float64 { return t.hours }), list.List[int]{6, 1, 3, 4, 2, 5}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ids(tt.query.ToList()))
+		})
+	}
+}
+
+func TestSkipAndTake(t *testing.T) {
+	byID := comparator.AscendingOrderBy(func(t ticket) int { return t.id })
+
+	assert.Equal(t, list.List[int]{3, 4}, ids(From(tickets).OrderBy(byID).Skip(2).Take(2).ToList()))
+	assert.Equal(t, list.List[int]{}, ids(From(tickets).Skip(10).ToList()))
+	assert.Equal(t, list.List[int]{1, 2, 3, 4, 5, 6}, ids(From(tickets).Skip(-1).Take(100).ToList()))
+	assert.Equal(t, list.List[int]{}, ids(From(tickets).Take(0).ToList()))
+}
+
+func TestOrderingAppliesBeforeLaterStages(t *testing.T) {
+	byHoursDescending := comparator.DescendingOrderBy(func(t ticket) float64 { return t.hours })
+
+	got := From(tickets).
+		OrderBy(byHoursDescending).
+		Take(3).
+		Where(func(t ticket) bool { return t.team != "ops" }).
+		ToList()
+
+	assert.Equal(t, list.List[int]{2, 6}, ids(got), "Take() should see the sorted elements")
+}
+
+func TestSelect(t *testing.T) {
+	got := Select(
+		From(tickets).Where(func(t ticket) bool { return t.priority == 1 }),
+		func(t ticket) string { return t.team },
+	).ToList()
+
+	assert.Equal(t, list.List[string]{"web", "api", "api"}, got)
+}
+
+func TestGroupBy(t *testing.T) {
+	type teamSummary struct {
+		team    string
+		count   int
+		hours   float64
+		highest int
+	}
+
+	summaries := GroupBy(
+		From(tickets),
+		func(t ticket) string { return t.team },
+		func(team string, group list.List[ticket]) teamSummary {
+			highest, _ := list.MinOf(group, func(t ticket) int { return t.priority })
+			return teamSummary{
+				team:    team,
+				count:   len(group),
+				hours:   list.SumBy(group, func(t ticket) float64 { return t.hours }),
+				highest: highest,
+			}
+		},
+	).OrderByDescending(comparator.AscendingOrderBy(func(s teamSummary) float64 { return s.hours })).ToList()
+
+	assert.Equal(t, list.List[teamSummary]{
+		{"api", 3, 9, 1},
+		{"ops", 1, 8, 3},
+		{"web", 2, 6, 1},
+	}, summaries)
+}
+
+func TestCountAndFirst(t *testing.T) {
+	apiTickets := From(tickets).Where(func(t ticket) bool { return t.team == "api" })
+
+	assert.Equal(t, 3, apiTickets.Count())
+
+	first, err := apiTickets.OrderByDescending(comparator.AscendingOrderBy(func(t ticket) int { return t.id })).First()
+	assert.NoError(t, err)
+	assert.Equal(t, 6, first.id)
+
+	_, err = From(tickets).Where(func(t ticket) bool { return false }).First()
+	assert.ErrorIs(t, err, list.ErrNoSuchElement)
+}
+
+func TestQueriesAreImmutable(t *testing.T) {
+	base := From(tickets).Where(func(t ticket) bool { return t.priority < 3 })
+	byID := comparator.AscendingOrderBy(func(t ticket) int { return t.id })
+
+	descending := base.OrderByDescending(byID)
+	limited := base.Take(1)
+
+	assert.Equal(t, list.List[int]{1, 2, 3, 5, 6}, ids(base.ToList()))
+	assert.Equal(t, list.List[int]{6, 5, 3, 2, 1}, ids(descending.ToList()))
+	assert.Equal(t, list.List[int]{1}, ids(limited.ToList()))
+	assert.Equal(t, list.List[int]{1, 2, 3, 4, 5, 6}, ids(tickets), "queries should not reorder the source list")
+}
@@ -0,0 +1,18 @@
+package query
+
+import (
+	"github.com/zach-robinson-dev/kollections/pkg/comparator"
+	"github.com/zach-robinson-dev/kollections/pkg/list"
+)
+
+type Query[T any] struct {
+	source    func() list.List[T]
+	stages    []stage[T]
+	ordering  comparator.Comparator[T]
+	sortedBy  comparator.Comparator[T]
+	sortStage int
+}
+
+type stage[T any] func(elements list.List[T]) list.List[T]
+
+type ProjectionFunc[K comparable, T any, R any] func(key K, group list.List[T]) R
@@ -0,0 +1,175 @@
+package stats
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+
+	"github.com/zach-robinson-dev/kollections/pkg/list"
+)
+
+var (
+	ErrInvalidPercentile          = errors.New("percentile must be between 0 and 100")
+	ErrInvalidInterpolationMethod = errors.New("unknown interpolation method")
+	ErrInvalidBucketCount         = errors.New("bucket count must be positive")
+	ErrNonFiniteValue             = errors.New("value must be finite")
+)
+
+func Median[N list.Number](values list.List[N]) (float64, error) {
+	return Percentile(values, 50, Linear)
+}
+
+func Percentile[N list.Number](values list.List[N], percentile float64, method InterpolationMethod) (float64, error) {
+	switch {
+	case len(values) == 0:
+		return 0, list.ErrEmptyList
+	case !(percentile >= 0 && percentile <= 100):
+		return 0, fmt.Errorf("%w: got %v", ErrInvalidPercentile, percentile)
+	}
+
+	if err := checkFinite(values); err != nil {
+		return 0, err
+	}
+
+	sorted := sortedCopy(values)
+
+	position := percentile / 100 * float64(len(sorted)-1)
+	lower, upper := int(math.Floor(position)), int(math.Ceil(position))
+	lowerValue, upperValue := float64(sorted[lower]), float64(sorted[upper])
+
+	switch method {
+	case Linear:
+		return lowerValue + (position-float64(lower))*(upperValue-lowerValue), nil
+	case Lower:
+		return lowerValue, nil
+	case Higher:
+		return upperValue, nil
+	case Nearest:
+		return float64(sorted[int(math.RoundToEven(position))]), nil
+	case Midpoint:
+		return (lowerValue + upperValue) / 2, nil
+	default:
+		return 0, fmt.Errorf("%w: %d", ErrInvalidInterpolationMethod, method)
+	}
+}
+
+func Variance[N list.Number](values list.List[N]) (float64, error) {
+	if len(values) == 0 {
+		return 0, list.ErrEmptyList
+	}
+
+	if err := checkFinite(values); err != nil {
+		return 0, err
+	}
+
+	mean, sumOfSquares := 0.0, 0.0
+
+	for index, element := range values {
+		value := float64(element)
+		delta := value - mean
+		mean += delta / float64(index+1)
+		sumOfSquares += delta * (value - mean)
+	}
+
+	return sumOfSquares / float64(len(values)), nil
+}
+
+func StdDev[N list.Number](values list.List[N]) (float64, error) {
+	variance, err := Variance(values)
+	if err != nil {
+		return 0, err
+	}
+
+	return math.Sqrt(variance), nil
+}
+
+func Mode[N list.Number](values list.List[N]) (list.List[N], error) {
+	if len(values) == 0 {
+		return nil, list.ErrEmptyList
+	}
+
+	if err := checkFinite(values); err != nil {
+		return nil, err
+	}
+
+	counts := make(map[N]int, len(values))
+	highestCount := 0
+
+	for _, element := range values {
+		counts[element]++
+		highestCount = max(highestCount, counts[element])
+	}
+
+	modes := make(list.List[N], 0)
+
+	for value, count := range counts {
+		if count == highestCount {
+			modes = append(modes, value)
+		}
+	}
+
+	slices.Sort(modes)
+
+	return modes, nil
+}
+
+func Histogram[N list.Number](values list.List[N], buckets int) (list.List[Bucket], error) {
+	switch {
+	case len(values) == 0:
+		return nil, list.ErrEmptyList
+	case buckets <= 0:
+		return nil, fmt.Errorf("%w: got %d", ErrInvalidBucketCount, buckets)
+	}
+
+	if err := checkFinite(values); err != nil {
+		return nil, err
+	}
+
+	minimum, maximum := float64(slices.Min(values)), float64(slices.Max(values))
+
+	// Work with half widths so that the span between extreme finite values cannot overflow.
+	halfWidth := (maximum/2 - minimum/2) / float64(buckets)
+
+	result := make(list.List[Bucket], buckets)
+
+	for index := range result {
+		result[index].Lower = bucketBound(minimum, halfWidth, index)
+		result[index].Upper = bucketBound(minimum, halfWidth, index+1)
+	}
+
+	result[buckets-1].Upper = maximum
+
+	for _, element := range values {
+		index := 0
+
+		if halfWidth > 0 {
+			index = min(int((float64(element)/2-minimum/2)/halfWidth), buckets-1)
+		}
+
+		result[index].Count++
+	}
+
+	return result, nil
+}
+
+func bucketBound(minimum float64, halfWidth float64, index int) float64 {
+	offset := float64(index) * halfWidth
+	return minimum + offset + offset
+}
+
+func checkFinite[N list.Number](values list.List[N]) error {
+	for _, element := range values {
+		if value := float64(element); math.IsNaN(value) || math.IsInf(value, 0) {
+			return fmt.Errorf("%w: got %v", ErrNonFiniteValue, value)
+		}
+	}
+
+	return nil
+}
+
+func sortedCopy[N list.Number](values list.List[N]) list.List[N] {
+	sorted := slices.Clone(values)
+	slices.Sort(sorted)
+	return sorted
+}
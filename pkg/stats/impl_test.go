@@ -0,0 +1,234 @@
+package stats
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zach-robinson-dev/kollections/pkg/list"
+)
+
+func TestMedian(t *testing.T) {
+	tests := []struct {
+		name    string
+		values  list.List[int]
+		want    float64
+		wantErr error
+	}{
+		{"Empty list", list.List[int]{}, 0, list.ErrEmptyList},
+		{"Odd length", list.List[int]{3, 1, 2}, 2, nil},
+		{"Even length", list.List[int]{4, 1, 3, 2}, 2.5, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Median(tt.values)
+			assert.ErrorIs(t, err, tt.wantErr, "Median() should return expected error")
+			assert.InDelta(t, tt.want, got, 1e-9, "Median() should return expected result")
+		})
+	}
+}
+
+func TestMedianDoesNotReorderInput(t *testing.T) {
+	values := list.List[int]{3, 1, 2}
+
+	_, _ = Median(values)
+
+	assert.Equal(t, list.List[int]{3, 1, 2}, values, "Median() should not sort the input in place")
+}
+
+func TestPercentile(t *testing.T) {
+	values := list.List[float64]{10, 20, 30, 40}
+
+	tests := []struct {
+		name       string
+		percentile float64
+		method     InterpolationMethod
+		want       float64
+	}{
+		{"Linear", 40, Linear, 22},
+		{"Lower", 40, Lower, 20},
+		{"Higher", 40, Higher, 30},
+		{"Nearest", 40, Nearest, 20},
+		{"Nearest rounds half to even", 50, Nearest, 30},
+		{"Midpoint", 40, Midpoint, 25},
+		{"Minimum", 0, Linear, 10},
+		{"Maximum", 100, Linear, 40},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Percentile(values, tt.percentile, tt.method)
+			assert.NoError(t, err)
+			assert.InDelta(t, tt.want, got, 1e-9, "Percentile() should return expected result")
+		})
+	}
+}
+
+func TestPercentileErrors(t *testing.T) {
+	values := list.List[int]{1, 2, 3}
+
+	_, err := Percentile(values, 101, Linear)
+	assert.ErrorIs(t, err, ErrInvalidPercentile)
+
+	_, err = Percentile(values, -1, Linear)
+	assert.ErrorIs(t, err, ErrInvalidPercentile)
+
+	_, err = Percentile(values, 50, InterpolationMethod(99))
+	assert.ErrorIs(t, err, ErrInvalidInterpolationMethod)
+
+	_, err = Percentile(list.List[int]{}, 50, Linear)
+	assert.ErrorIs(t, err, list.ErrEmptyList)
+}
+
+func TestNonFiniteValues(t *testing.T) {
+	tests := []struct {
+		name   string
+		values list.List[float64]
+	}{
+		{"NaN", list.List[float64]{math.NaN(), 1, 2}},
+		{"Repeated NaN", list.List[float64]{math.NaN(), math.NaN(), 1}},
+		{"Positive infinity", list.List[float64]{1, math.Inf(1)}},
+		{"Negative infinity", list.List[float64]{math.Inf(-1), 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Median(tt.values)
+			assert.ErrorIs(t, err, ErrNonFiniteValue, "Median() should reject non-finite values")
+
+			_, err = Percentile(tt.values, 90, Nearest)
+			assert.ErrorIs(t, err, ErrNonFiniteValue, "Percentile() should reject non-finite values")
+
+			_, err = Variance(tt.values)
+			assert.ErrorIs(t, err, ErrNonFiniteValue, "Variance() should reject non-finite values")
+
+			_, err = StdDev(tt.values)
+			assert.ErrorIs(t, err, ErrNonFiniteValue, "StdDev() should reject non-finite values")
+
+			modes, err := Mode(tt.values)
+			assert.ErrorIs(t, err, ErrNonFiniteValue, "Mode() should reject non-finite values")
+			assert.Nil(t, modes)
+		})
+	}
+}
+
+func TestVarianceAndStdDev(t *testing.T) {
+	values := list.List[int]{2, 4, 4, 4, 5, 5, 7, 9}
+
+	variance, err := Variance(values)
+	assert.NoError(t, err)
+	assert.InDelta(t, 4.0, variance, 1e-9, "Variance() should return the population variance")
+
+	stdDev, err := StdDev(values)
+	assert.NoError(t, err)
+	assert.InDelta(t, 2.0, stdDev, 1e-9, "StdDev() should return the population standard deviation")
+
+	_, err = Variance(list.List[int]{})
+	assert.ErrorIs(t, err, list.ErrEmptyList)
+
+	_, err = StdDev(list.List[int]{})
+	assert.ErrorIs(t, err, list.ErrEmptyList)
+}
+
+func TestMode(t *testing.T) {
+	tests := []struct {
+		name    string
+		values  list.List[int]
+		want    list.List[int]
+		wantErr error
+	}{
+		{"Empty list", list.List[int]{}, nil, list.ErrEmptyList},
+		{"Single mode", list.List[int]{1, 2, 2, 3}, list.List[int]{2}, nil},
+		{"Multiple modes sorted", list.List[int]{3, 3, 1, 1, 2}, list.List[int]{1, 3}, nil},
+		{"All unique", list.List[int]{2, 1}, list.List[int]{1, 2}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Mode(tt.values)
+			assert.ErrorIs(t, err, tt.wantErr, "Mode() should return expected error")
+			assert.Equal(t, tt.want, got, "Mode() should return expected result")
+		})
+	}
+}
+
+func TestHistogram(t *testing.T) {
+	tests := []struct {
+		name    string
+		values  list.List[float64]
+		buckets int
+		want    list.List[Bucket]
+		wantErr error
+	}{
+		{
+			name:    "Evenly spread values",
+			values:  list.List[float64]{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10},
+			buckets: 2,
+			want:    list.List[Bucket]{{0, 5, 5}, {5, 10, 6}},
+		},
+		{
+			name:    "Maximum lands in last bucket",
+			values:  list.List[float64]{0, 10},
+			buckets: 5,
+			want:    list.List[Bucket]{{0, 2, 1}, {2, 4, 0}, {4, 6, 0}, {6, 8, 0}, {8, 10, 1}},
+		},
+		{
+			name:    "Identical values",
+			values:  list.List[float64]{3, 3, 3},
+			buckets: 2,
+			want:    list.List[Bucket]{{3, 3, 3}, {3, 3, 0}},
+		},
+		{
+			name:    "Extreme finite values",
+			values:  list.List[float64]{-1e308, 1e308},
+			buckets: 2,
+			want:    list.List[Bucket]{{-1e308, 0, 1}, {0, 1e308, 1}},
+		},
+		{
+			name:    "Extreme finite values in one bucket",
+			values:  list.List[float64]{-math.MaxFloat64, 0, math.MaxFloat64},
+			buckets: 1,
+			want:    list.List[Bucket]{{-math.MaxFloat64, math.MaxFloat64, 3}},
+		},
+		{
+			name:    "NaN value",
+			values:  list.List[float64]{1, math.NaN(), 3},
+			buckets: 2,
+			wantErr: ErrNonFiniteValue,
+		},
+		{
+			name:    "Infinite value",
+			values:  list.List[float64]{1, math.Inf(1)},
+			buckets: 2,
+			wantErr: ErrNonFiniteValue,
+		},
+		{
+			name:    "Negative infinite value",
+			values:  list.List[float64]{math.Inf(-1), 1},
+			buckets: 2,
+			wantErr: ErrNonFiniteValue,
+		},
+		{
+			name:    "Empty list",
+			values:  list.List[float64]{},
+			buckets: 2,
+			wantErr: list.ErrEmptyList,
+		},
+		{
+			name:    "Invalid bucket count",
+			values:  list.List[float64]{1},
+			buckets: 0,
+			wantErr: ErrInvalidBucketCount,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Histogram(tt.values, tt.buckets)
+			assert.ErrorIs(t, err, tt.wantErr, "Histogram() should return expected error")
+			assert.Equal(t, tt.want, got, "Histogram() should return expected result")
+		})
+	}
+}
@@ -0,0 +1,17 @@
+package stats
+
+type InterpolationMethod int
+
+const (
+	Linear InterpolationMethod = iota
+	Lower
+	Higher
+	Nearest
+	Midpoint
+)
+
+type Bucket struct {
+	Lower float64
+	Upper float64
+	Count int
+}
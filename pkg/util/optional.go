@@ -0,0 +1,98 @@
+package util
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+type Optional[T any] struct {
+	value     T
+	isPresent bool
+}
+
+func OptionalOf[T any](value T) Optional[T] {
+	return Optional[T]{value: value, isPresent: true}
+}
+
+func EmptyOptional[T any]() Optional[T] {
+	return Optional[T]{}
+}
+
+func OptionalFromPointer[T any](pointer *T) Optional[T] {
+	switch pointer {
+	case nil:
+		return EmptyOptional[T]()
+	default:
+		return OptionalOf(*pointer)
+	}
+}
+
+func (o Optional[T]) Get() (T, bool) {
+	return o.value, o.isPresent
+}
+
+func (o Optional[T]) IsPresent() bool {
+	return o.isPresent
+}
+
+func (o Optional[T]) OrElse(other T) T {
+	switch o.isPresent {
+	case true:
+		return o.value
+	default:
+		return other
+	}
+}
+
+func (o Optional[T]) OrElseGet(supplier func() T) T {
+	switch o.isPresent {
+	case true:
+		return o.value
+	default:
+		return supplier()
+	}
+}
+
+func (o Optional[T]) Filter(predicate func(value T) bool) Optional[T] {
+	switch {
+	case o.isPresent && predicate(o.value):
+		return o
+	default:
+		return EmptyOptional[T]()
+	}
+}
+
+func MapOptional[T any, R any](optional Optional[T], transform func(value T) R) Optional[R] {
+	switch value, isPresent := optional.Get(); isPresent {
+	case true:
+		return OptionalOf(transform(value))
+	default:
+		return EmptyOptional[R]()
+	}
+}
+
+func (o Optional[T]) MarshalJSON() ([]byte, error) {
+	switch o.isPresent {
+	case true:
+		return json.Marshal(o.value)
+	default:
+		return []byte("null"), nil
+	}
+}
+
+func (o *Optional[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		*o = EmptyOptional[T]()
+		return nil
+	}
+
+	var value T
+
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+
+	*o = OptionalOf(value)
+
+	return nil
+}
@@ -0,0 +1,81 @@
+package util
+
+import (
+	"encoding/json"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOptionalGet(t *testing.T) {
+	value, isPresent := OptionalOf(0).Get()
+	assert.True(t, isPresent, "a present zero value should be distinguishable from empty")
+	assert.Equal(t, 0, value)
+
+	value, isPresent = EmptyOptional[int]().Get()
+	assert.False(t, isPresent)
+	assert.Equal(t, 0, value)
+}
+
+func TestOptionalFromPointer(t *testing.T) {
+	assert.Equal(t, OptionalOf(5), OptionalFromPointer(PointerTo(5)))
+	assert.Equal(t, EmptyOptional[int](), OptionalFromPointer[int](nil))
+}
+
+func TestOptionalIsPresent(t *testing.T) {
+	assert.True(t, OptionalOf("x").IsPresent())
+	assert.False(t, EmptyOptional[string]().IsPresent())
+
+	var zero Optional[string]
+	assert.False(t, zero.IsPresent(), "the zero value should be empty")
+}
+
+func TestOptionalOrElse(t *testing.T) {
+	assert.Equal(t, 1, OptionalOf(1).OrElse(2))
+	assert.Equal(t, 2, EmptyOptional[int]().OrElse(2))
+}
+
+func TestOptionalOrElseGet(t *testing.T) {
+	calls := 0
+	supplier := func() int {
+		calls++
+		return 2
+	}
+
+	assert.Equal(t, 1, OptionalOf(1).OrElseGet(supplier))
+	assert.Equal(t, 0, calls, "OrElseGet() should not call the supplier when a value is present")
+
+	assert.Equal(t, 2, EmptyOptional[int]().OrElseGet(supplier))
+	assert.Equal(t, 1, calls)
+}
+
+func TestOptionalFilter(t *testing.T) {
+	isEven := func(x int) bool { return x%2 == 0 }
+
+	assert.Equal(t, OptionalOf(2), OptionalOf(2).Filter(isEven))
+	assert.Equal(t, EmptyOptional[int](), OptionalOf(3).Filter(isEven))
+	assert.Equal(t, EmptyOptional[int](), EmptyOptional[int]().Filter(isEven))
+}
+
+func TestMapOptional(t *testing.T) {
+	assert.Equal(t, OptionalOf("42"), MapOptional(OptionalOf(42), strconv.Itoa))
+	assert.Equal(t, EmptyOptional[string](), MapOptional(EmptyOptional[int](), strconv.Itoa))
+}
+
+func TestOptionalJSON(t *testing.T) {
+	type response struct {
+		Count Optional[int]    `json:"count"`
+		Name  Optional[string] `json:"name"`
+	}
+
+	data, err := json.Marshal(response{Count: OptionalOf(0)})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"count": 0, "name": null}`, string(data))
+
+	var decoded response
+	assert.NoError(t, json.Unmarshal([]byte(`{"count": 3, "name": null}`), &decoded))
+	assert.Equal(t, response{Count: OptionalOf(3), Name: EmptyOptional[string]()}, decoded)
+
+	assert.Error(t, json.Unmarshal([]byte(`{"count": "three"}`), &decoded))
+}